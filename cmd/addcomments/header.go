@@ -0,0 +1,134 @@
+// cmd/addcomments/header.go
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// commentStyle 은 확장자별 한 줄 주석 형식입니다.
+type commentStyle struct {
+	prefix string
+	suffix string
+}
+
+var commentStyles = map[string]commentStyle{
+	".go":  {prefix: "//"},
+	".ts":  {prefix: "//"},
+	".tsx": {prefix: "//"},
+	".js":  {prefix: "//"},
+	".jsx": {prefix: "//"},
+	".css": {prefix: "/*", suffix: "*/"},
+}
+
+type options struct {
+	root    string
+	write   bool
+	include []string
+	exclude []string
+}
+
+// run 은 root 아래를 순회하며 헤더가 없는 파일 목록을 반환합니다.
+// opts.write 가 true 면 해당 파일에 헤더를 추가합니다.
+func run(opts options) ([]string, error) {
+	var missing []string
+
+	err := filepath.WalkDir(opts.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(opts.root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel != "." && matchAny(opts.exclude, rel) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !matchAny(opts.include, rel) {
+			return nil
+		}
+
+		style, ok := commentStyles[filepath.Ext(path)]
+		if !ok {
+			return nil
+		}
+
+		changed, err := ensureHeader(path, rel, style, opts.write)
+		if err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		if changed {
+			missing = append(missing, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking the path: %w", err)
+	}
+
+	return missing, nil
+}
+
+// ensureHeader 는 첫 줄이 주석이 아니면 true 를 반환하고, write 가 true 면 헤더를 추가합니다.
+func ensureHeader(path, rel string, style commentStyle, write bool) (bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	if hasHeader(lines[0], style) {
+		return false, nil
+	}
+	if !write {
+		return true, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+
+	header := style.prefix + " " + rel
+	if style.suffix != "" {
+		header += " " + style.suffix
+	}
+
+	lines = append([]string{header, ""}, lines...)
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func hasHeader(line string, style commentStyle) bool {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, style.prefix) {
+		return false
+	}
+	return style.suffix == "" || strings.HasSuffix(line, style.suffix)
+}
+
+// matchAny 는 상대 경로 또는 파일 이름이 패턴 중 하나와 일치하는지 확인합니다.
+func matchAny(patterns []string, rel string) bool {
+	base := filepath.Base(rel)
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// cmd/addcomments/main.go
+
+// addcomments 는 소스 파일 맨 앞에 "// 경로" 형태의 헤더 주석을 붙이는 개발용 도구입니다.
+// 앱 바이너리와는 분리되어 있으며 저장소 루트에서 직접 실행합니다.
+//
+//	go run ./cmd/addcomments            # 헤더가 없는 파일에 헤더 추가
+//	go run ./cmd/addcomments -dry-run   # 변경될 파일만 출력
+//	go run ./cmd/addcomments -check     # 헤더가 없는 파일이 있으면 exit 1
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	root := flag.String("root", ".", "walk root directory")
+	dryRun := flag.Bool("dry-run", false, "print files that would be changed without writing them")
+	check := flag.Bool("check", false, "exit with status 1 when any file is missing its header")
+	include := flag.String("include", "*.go,*.tsx,*.css", "comma separated globs of files to process")
+	exclude := flag.String("exclude", "node_modules,dist,build,wailsjs,.git,.idea", "comma separated globs of files or directories to skip")
+	flag.Parse()
+
+	opts := options{
+		root:    *root,
+		write:   !*dryRun && !*check,
+		include: splitList(*include),
+		exclude: splitList(*exclude),
+	}
+
+	missing, err := run(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "addcomments: %v\n", err)
+		os.Exit(2)
+	}
+
+	for _, path := range missing {
+		switch {
+		case *check:
+			fmt.Printf("missing header: %s\n", path)
+		case *dryRun:
+			fmt.Printf("would add comment to %s\n", path)
+		default:
+			fmt.Printf("Added comment to %s\n", path)
+		}
+	}
+
+	if *check && len(missing) > 0 {
+		os.Exit(1)
+	}
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
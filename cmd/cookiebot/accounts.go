@@ -0,0 +1,205 @@
+// cmd/cookiebot/accounts.go
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"cookieBot/internal/db"
+
+	"go.uber.org/zap"
+)
+
+// accountsList 는 모든 계정을 출력합니다. 비밀번호는 -reveal 이 없으면 가립니다.
+func accountsList(e *env, args []string) error {
+	fs := flag.NewFlagSet("accounts list", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	reveal := fs.Bool("reveal", false, "")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return usagef("usage: accounts list [-reveal]")
+	}
+
+	store, err := e.store()
+	if err != nil {
+		return err
+	}
+	accounts, err := store.ListEmails()
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	rows := make([][]string, 0, len(accounts))
+	for i, a := range accounts {
+		if !*reveal {
+			accounts[i].MaskPassword()
+		}
+		rows = append(rows, []string{a.Email, a.RecoveryEmail, strconv.FormatBool(a.Used)})
+	}
+	return e.out.print(accounts, []string{"EMAIL", "RECOVERY", "USED"}, rows)
+}
+
+// accountsGet 은 계정 하나를 출력합니다. 비밀번호는 -reveal 이 없으면 가립니다.
+func accountsGet(e *env, args []string) error {
+	fs := flag.NewFlagSet("accounts get", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	reveal := fs.Bool("reveal", false, "")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return usagef("usage: accounts get [-reveal] <email>")
+	}
+
+	store, err := e.store()
+	if err != nil {
+		return err
+	}
+	account, err := store.GetEmail(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if !*reveal {
+		account.MaskPassword()
+	}
+
+	rows := [][]string{{account.Email, account.RecoveryEmail, strconv.FormatBool(account.Used)}}
+	return e.out.print(account, []string{"EMAIL", "RECOVERY", "USED"}, rows)
+}
+
+// accountsImport 는 CSV/JSON 파일을 검증한 뒤 문제가 없는 행만 저장합니다. -dry-run 이면 검증 결과만 출력합니다.
+func accountsImport(e *env, args []string) error {
+	const usage = "usage: accounts import [-dry-run] [-format csv|json] [-delimiter ,] [-no-header] [-map field=column,...] <file>"
+
+	fs := flag.NewFlagSet("accounts import", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	dryRun := fs.Bool("dry-run", false, "")
+	var opts db.ImportOptions
+	fs.StringVar(&opts.Format, "format", "", "")
+	fs.StringVar(&opts.Delimiter, "delimiter", "", "")
+	fs.BoolVar(&opts.NoHeader, "no-header", false, "")
+	mapping := fs.String("map", "", "")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return usagef(usage)
+	}
+	if *mapping != "" {
+		opts.Columns = make(map[string]string)
+		for _, pair := range strings.Split(*mapping, ",") {
+			field, column, ok := strings.Cut(pair, "=")
+			if !ok {
+				return usagef(usage)
+			}
+			opts.Columns[strings.TrimSpace(field)] = strings.TrimSpace(column)
+		}
+	}
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	store, err := e.store()
+	if err != nil {
+		return err
+	}
+	preview, err := store.ImportAccounts(data, opts, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(preview.Rows))
+	for i, r := range preview.Rows {
+		preview.Rows[i].Account.MaskPassword()
+		status := "ok"
+		if len(r.Issues) > 0 {
+			status = strings.Join(r.Issues, "; ")
+		}
+		rows = append(rows, []string{strconv.Itoa(r.Line), r.Account.Email, status})
+	}
+	if err := e.out.print(preview, []string{"LINE", "EMAIL", "STATUS"}, rows); err != nil {
+		return err
+	}
+	if preview.Invalid > 0 {
+		return fmt.Errorf("%d of %d rows have issues", preview.Invalid, len(preview.Rows))
+	}
+	if failed := preview.Valid - preview.Imported; !preview.DryRun && failed > 0 {
+		return fmt.Errorf("%d of %d valid rows could not be saved", failed, preview.Valid)
+	}
+	return nil
+}
+
+// accountsCheck 는 계정 테이블 구성을 확인하고, -create 이면 없는 테이블을 만듭니다.
+func accountsCheck(e *env, args []string) error {
+	fs := flag.NewFlagSet("accounts check", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	create := fs.Bool("create", false, "")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return usagef("usage: accounts check [-create]")
+	}
+
+	store, err := e.store()
+	if err != nil {
+		return err
+	}
+	err = store.CheckSchema(e.ctx)
+	var schemaErr *db.SchemaError
+	if *create && errors.As(err, &schemaErr) && schemaErr.Missing {
+		if err := store.CreateTable(e.ctx); err != nil {
+			return err
+		}
+		return e.out.printResult(map[string]interface{}{"table": store.TableName(), "status": "created"})
+	}
+	if err != nil {
+		return err
+	}
+	return e.out.printResult(map[string]interface{}{"table": store.TableName(), "status": "ok"})
+}
+
+// accountsMigrate 는 계정 저장소 마이그레이션을 실행합니다. -dry-run 이면 바뀔 항목 수만 출력합니다.
+func accountsMigrate(e *env, args []string) error {
+	fs := flag.NewFlagSet("accounts migrate", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	dryRun := fs.Bool("dry-run", false, "")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return usagef("usage: accounts migrate [-dry-run]")
+	}
+
+	store, err := e.store()
+	if err != nil {
+		return err
+	}
+	report, err := store.Migrate(e.ctx, db.MigrationOptions{
+		DryRun: *dryRun,
+		OnProgress: func(p db.MigrationProgress) {
+			e.logger.Info("Migration progress", zap.Int("version", p.Version), zap.Int("scanned", p.Scanned), zap.Int("changed", p.Changed))
+		},
+	})
+	if report != nil {
+		rows := make([][]string, 0, len(report.Applied))
+		for _, p := range report.Applied {
+			rows = append(rows, []string{strconv.Itoa(p.Version), p.Name, strconv.Itoa(p.Scanned), strconv.Itoa(p.Changed), strconv.FormatBool(p.Done)})
+		}
+		if perr := e.out.print(report, []string{"VERSION", "NAME", "SCANNED", "CHANGED", "DONE"}, rows); perr != nil {
+			return perr
+		}
+	}
+	return err
+}
+
+func accountsDelete(e *env, args []string) error {
+	if len(args) != 1 {
+		return usagef("usage: accounts delete <email>")
+	}
+
+	store, err := e.store()
+	if err != nil {
+		return err
+	}
+	if err := store.DeleteEmail(args[0]); err != nil {
+		return fmt.Errorf("failed to delete %s: %w", args[0], err)
+	}
+
+	return e.out.printResult(map[string]interface{}{"deleted": args[0]})
+}
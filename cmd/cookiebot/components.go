@@ -0,0 +1,110 @@
+// cmd/cookiebot/components.go
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	antidetect "cookieBot/internal/anti"
+	"cookieBot/internal/vm"
+)
+
+func vmStatus(e *env, args []string) error {
+	if len(args) != 0 {
+		return usagef("usage: vm status")
+	}
+
+	status := vm.VMMain(e.logger).CheckVMWareStatus()
+	rows := [][]string{
+		{"vmrun_exists", strconv.FormatBool(status.VmrunExists)},
+		{"vmware_exists", strconv.FormatBool(status.VmwareExists)},
+		{"vm_folder_exists", strconv.FormatBool(status.VmFolderExists)},
+	}
+	return e.out.print(status, []string{"CHECK", "OK"}, rows)
+}
+
+func vmList(e *env, args []string) error {
+	if len(args) != 0 {
+		return usagef("usage: vm list")
+	}
+
+	machines, err := vm.VMMain(e.logger).ListVirtualMachines()
+	if err != nil {
+		return fmt.Errorf("failed to list virtual machines: %w", err)
+	}
+
+	rows := make([][]string, 0, len(machines))
+	for _, m := range machines {
+		rows = append(rows, []string{m.Name, m.Path})
+	}
+	return e.out.print(machines, []string{"NAME", "PATH"}, rows)
+}
+
+// componentStatus 는 외부 구성 요소의 설치/실행 상태입니다.
+type componentStatus struct {
+	Name      string `json:"name"`
+	Installed bool   `json:"installed"`
+	Running   *bool  `json:"running,omitempty"`
+}
+
+func componentsStatus(e *env, args []string) error {
+	if len(args) != 0 {
+		return usagef("usage: components status")
+	}
+
+	add := antidetect.AntiDetectDownload(e.ctx, e.logger)
+	installed, err := add.IsAntiDetectInstalled()
+	if err != nil {
+		return err
+	}
+	anti := componentStatus{Name: "antidetect", Installed: installed}
+	if installed {
+		running, err := add.IsAntiDetectRunning()
+		if err != nil {
+			return err
+		}
+		anti.Running = &running
+	}
+
+	vmware := vm.VMMain(e.logger).CheckVMWareStatus()
+	statuses := []componentStatus{
+		anti,
+		{Name: "vmware", Installed: vmware.VmwareExists && vmware.VmrunExists},
+	}
+
+	rows := make([][]string, 0, len(statuses))
+	for _, s := range statuses {
+		running := "-"
+		if s.Running != nil {
+			running = strconv.FormatBool(*s.Running)
+		}
+		rows = append(rows, []string{s.Name, strconv.FormatBool(s.Installed), running})
+	}
+	return e.out.print(statuses, []string{"COMPONENT", "INSTALLED", "RUNNING"}, rows)
+}
+
+func componentsInstall(e *env, args []string) error {
+	if len(args) != 1 {
+		return usagef("usage: components install <antidetect|vmware>")
+	}
+
+	switch args[0] {
+	case "antidetect":
+		add := antidetect.AntiDetectDownload(e.ctx, e.logger)
+		add.SetAuditLog(e.audit)
+		if err := add.DownloadAndInstallAntiDetect(); err != nil {
+			return fmt.Errorf("failed to install antidetect: %w", err)
+		}
+	case "vmware":
+		vmd := vm.VMDownload(e.ctx, e.logger)
+		vmd.SetAuditLog(e.audit)
+		if err := vmd.DownloadAndInstallVMWare(); err != nil {
+			return fmt.Errorf("failed to install vmware: %w", err)
+		}
+	default:
+		return usagef("unknown component %q", args[0])
+	}
+
+	return e.out.printResult(map[string]interface{}{"installed": args[0]})
+}
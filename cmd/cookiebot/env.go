@@ -0,0 +1,162 @@
+// cmd/cookiebot/env.go
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"cookieBot/internal/applock"
+	"cookieBot/internal/audit"
+	"cookieBot/internal/browser"
+	"cookieBot/internal/db"
+	"cookieBot/internal/secrets"
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+// env 는 하위 명령이 공유하는 실행 환경입니다.
+type env struct {
+	ctx        context.Context
+	logger     *zap.Logger
+	configPath string
+	out        *printer
+	// GUI 와 같은 감사 로그 (열 수 없으면 nil, 기록 생략)
+	audit *audit.Log
+	// 브라우저 공급자 (-provider). 비어 있으면 GUI 에서 선택한 공급자를 씁니다.
+	provider string
+	// GUI 와 같은 앱 잠금 (unlock 에서 처음 열림)
+	lock *applock.Lock
+}
+
+// browser 는 감사 로그가 연결된 BrowserManager 를 만듭니다.
+func (e *env) browser() *browser.BrowserManager {
+	bm := browser.NewBrowserManager(e.ctx, e.logger)
+	bm.SetAuditLog(e.audit)
+
+	name := e.provider
+	if name == "" {
+		if dir, err := utils.AppDataDir(); err == nil {
+			name, _ = browser.LoadProviderSetting(filepath.Join(dir, "provider.json"))
+		}
+	}
+	if name != "" {
+		if err := bm.UseProvider(name); err != nil {
+			e.logger.Warn("Failed to select browser provider", zap.String("provider", name), zap.Error(err))
+		}
+	}
+	return bm
+}
+
+// store 는 감사 로그가 연결된 계정 저장소를 엽니다. GUI 와 같이 계정 정보는 앱 잠금이 풀려야 읽을 수 있습니다.
+func (e *env) store() (*db.EmailDB, error) {
+	if _, err := e.unlock(); err != nil {
+		return nil, err
+	}
+	secretStore, err := e.secrets()
+	if err != nil {
+		return nil, err
+	}
+	store, err := db.NewEmailDB(e.configPath, secretStore)
+	if err != nil {
+		return nil, err
+	}
+	store.SetAuditLog(e.audit)
+	return store, nil
+}
+
+// unlock 은 GUI 와 같은 앱 잠금을 COOKIEBOT_PASSPHRASE 의 마스터 패스프레이즈로 풉니다.
+// 마스터 패스프레이즈가 아직 설정되지 않았으면 GUI 에서 먼저 설정해야 합니다.
+func (e *env) unlock() (*applock.Lock, error) {
+	if e.lock == nil {
+		dir, err := utils.AppDataDir()
+		if err != nil {
+			return nil, err
+		}
+		lock, err := applock.Open(filepath.Join(dir, "applock.json"))
+		if err != nil {
+			return nil, err
+		}
+		e.lock = lock
+	}
+
+	status := e.lock.Status()
+	if !status.Configured {
+		return nil, fmt.Errorf("%w; set it in the app first", applock.ErrNotConfigured)
+	}
+	if status.Locked {
+		passphrase := os.Getenv("COOKIEBOT_PASSPHRASE")
+		if passphrase == "" {
+			return nil, fmt.Errorf("%w; set COOKIEBOT_PASSPHRASE", applock.ErrLocked)
+		}
+		if err := e.lock.Unlock(passphrase); err != nil {
+			return nil, err
+		}
+	}
+	return e.lock, nil
+}
+
+// secrets 는 GUI 와 같은 비밀 저장소를 엽니다. OS 키링이 없으면 암호화 파일을 쓰며,
+// 이때 키는 COOKIEBOT_PASSPHRASE 의 마스터 패스프레이즈로 필요할 때 유도합니다.
+func (e *env) secrets() (secrets.Store, error) {
+	dir, err := utils.AppDataDir()
+	if err != nil {
+		return nil, err
+	}
+
+	key := func() ([]byte, error) {
+		lock, err := e.unlock()
+		if err != nil {
+			return nil, err
+		}
+		return lock.Key()
+	}
+	return secrets.Open(filepath.Join(dir, "secrets.enc"), key), nil
+}
+
+// printer 는 결과를 JSON 또는 표 형식으로 출력합니다.
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) *printer {
+	return &printer{w: w, format: format}
+}
+
+// print 는 JSON 모드에서는 v 를 그대로, 표 모드에서는 header 와 rows 를 출력합니다.
+func (p *printer) print(v interface{}, header []string, rows [][]string) error {
+	if p.format == "json" {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// printResult 는 단순 작업 결과(map 응답 등)를 출력합니다.
+func (p *printer) printResult(v map[string]interface{}) error {
+	if p.format == "json" {
+		return p.print(v, nil, nil)
+	}
+
+	keys := sortedKeys(v)
+	rows := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, []string{k, fmt.Sprint(v[k])})
+	}
+	return p.print(v, []string{"KEY", "VALUE"}, rows)
+}
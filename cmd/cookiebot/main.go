@@ -0,0 +1,194 @@
+// cmd/cookiebot/main.go
+
+// cookiebot 은 GUI 없이 Wails 바인딩과 같은 동작을 실행하는 헤드리스 CLI 입니다.
+//
+//	cookiebot [-o json|table] [-config config.json] [-provider name] [-v] <group> <command> [args]
+//
+//	profiles   list | info <id> | start <id> | stop <id> | rm <id>
+//	           export <file> <id>... | import <file> [skip|rename|overwrite]
+//	accounts   list [-reveal] | get [-reveal] <email> | import [-dry-run] <file.csv|json> | check [-create]
+//	           migrate [-dry-run] | delete <email>
+//	vm         status | list
+//	components status | install <antidetect|vmware>
+//	secrets    set <name> (value on stdin) | delete <name>
+//
+// 종료 코드: 0 성공, 1 작업 실패, 2 잘못된 사용법.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"cookieBot/internal/audit"
+	"cookieBot/internal/browser"
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// usageError 는 잘못된 인자로 인한 오류를 나타내며 종료 코드 2 로 처리됩니다.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// command 는 하위 명령 하나를 실행합니다.
+type command func(env *env, args []string) error
+
+var groups = map[string]map[string]command{
+	"profiles": {
+		"list":   profilesList,
+		"info":   profilesInfo,
+		"start":  profilesStart,
+		"stop":   profilesStop,
+		"rm":     profilesRemove,
+		"export": profilesExport,
+		"import": profilesImport,
+	},
+	"accounts": {
+		"list":    accountsList,
+		"get":     accountsGet,
+		"import":  accountsImport,
+		"check":   accountsCheck,
+		"migrate": accountsMigrate,
+		"delete":  accountsDelete,
+	},
+	"vm": {
+		"status": vmStatus,
+		"list":   vmList,
+	},
+	"components": {
+		"status":  componentsStatus,
+		"install": componentsInstall,
+	},
+	"secrets": {
+		"set":    secretsSet,
+		"delete": secretsDelete,
+	},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	fs := flag.NewFlagSet("cookiebot", flag.ContinueOnError)
+	output := fs.String("o", "table", "output format: json or table")
+	configPath := fs.String("config", defaultConfigPath(), "path to config.json for the account store")
+	verbose := fs.Bool("v", false, "write info/debug logs to stderr")
+	provider := fs.String("provider", "", "browser provider: "+strings.Join(browser.ProviderNames(), " or ")+" (default: the one selected in the app)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: cookiebot [flags] <profiles|accounts|vm|components|secrets> <command> [args]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if *output != "json" && *output != "table" {
+		fmt.Fprintf(os.Stderr, "cookiebot: unknown output format %q\n", *output)
+		return exitUsage
+	}
+	if *provider != "" && !contains(browser.ProviderNames(), *provider) {
+		fmt.Fprintf(os.Stderr, "cookiebot: unknown browser provider %q\n", *provider)
+		return exitUsage
+	}
+
+	rest := fs.Args()
+	if len(rest) < 2 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	group, ok := groups[rest[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "cookiebot: unknown command group %q\n", rest[0])
+		return exitUsage
+	}
+	cmd, ok := group[rest[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "cookiebot: unknown %s command %q\n", rest[0], rest[1])
+		return exitUsage
+	}
+
+	logger, err := utils.InitializeCLILogger(*verbose)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cookiebot: failed to initialize logger: %v\n", err)
+		return exitError
+	}
+	defer logger.Sync()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	e := &env{
+		ctx:        ctx,
+		logger:     logger,
+		configPath: *configPath,
+		out:        newPrinter(os.Stdout, *output),
+		audit:      openAuditLog(logger),
+		provider:   *provider,
+	}
+	defer e.audit.Close()
+
+	if err := cmd(e, rest[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "cookiebot: %v\n", err)
+		var ue *usageError
+		if errors.As(err, &ue) {
+			return exitUsage
+		}
+		return exitError
+	}
+	return exitOK
+}
+
+func defaultConfigPath() string {
+	if path := os.Getenv("COOKIEBOT_CONFIG"); path != "" {
+		return path
+	}
+	return "config.json"
+}
+
+// openAuditLog 는 GUI 와 같은 위치의 감사 로그를 엽니다. 실패하면 기록 없이 계속합니다.
+func openAuditLog(logger *zap.Logger) *audit.Log {
+	dir, err := utils.AppDataDir()
+	if err == nil {
+		var l *audit.Log
+		if l, err = audit.Open(filepath.Join(dir, "audit.jsonl")); err == nil {
+			return l
+		}
+	}
+	logger.Warn("Audit log unavailable", zap.Error(err))
+	return nil
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+// cmd/cookiebot/profiles.go
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cookieBot/internal/browser"
+)
+
+func profilesList(e *env, args []string) error {
+	if len(args) != 0 {
+		return usagef("usage: profiles list")
+	}
+
+	bm := e.browser()
+	resp, err := bm.FetchProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to fetch profiles: %w", err)
+	}
+
+	ids := sortedKeys(resp.Data)
+	rows := make([][]string, 0, len(ids))
+	for _, id := range ids {
+		p := resp.Data[id]
+		rows = append(rows, []string{id, p.Name, p.Status, p.Folder, strings.Join(p.Tags, ",")})
+	}
+	return e.out.print(resp.Data, []string{"ID", "NAME", "STATUS", "FOLDER", "TAGS"}, rows)
+}
+
+func profilesInfo(e *env, args []string) error {
+	if len(args) != 1 {
+		return usagef("usage: profiles info <id>")
+	}
+
+	bm := e.browser()
+	resp, err := bm.FetchProfileInfo(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to fetch profile %s: %w", args[0], err)
+	}
+
+	p := resp.Data
+	rows := [][]string{
+		{"name", p.Name},
+		{"status", p.Status},
+		{"folder", p.Folder},
+		{"tags", strings.Join(p.Tags, ",")},
+		{"browser", p.Browser},
+		{"os", p.OS},
+		{"screen", p.Screen},
+		{"language", p.Language},
+		{"cpu", strconv.Itoa(p.CPU)},
+		{"memory", strconv.Itoa(p.Memory)},
+		{"proxy", p.Proxy},
+		{"debug_port", p.DebugPort},
+		{"websocket_link", p.WebsocketLink},
+		{"notes", p.Notes},
+	}
+	return e.out.print(p, []string{"FIELD", "VALUE"}, rows)
+}
+
+func profilesStart(e *env, args []string) error {
+	return profileAction(e, args, "start", (*browser.BrowserManager).LaunchProfile)
+}
+
+func profilesStop(e *env, args []string) error {
+	return profileAction(e, args, "stop", (*browser.BrowserManager).StopProfile)
+}
+
+func profilesRemove(e *env, args []string) error {
+	return profileAction(e, args, "rm", (*browser.BrowserManager).RemoveProfile)
+}
+
+func profileAction(e *env, args []string, name string, action func(*browser.BrowserManager, string) (map[string]interface{}, error)) error {
+	if len(args) != 1 {
+		return usagef("usage: profiles %s <id>", name)
+	}
+
+	bm := e.browser()
+	resp, err := action(bm, args[0])
+	if err != nil {
+		return fmt.Errorf("profiles %s %s: %w", name, args[0], err)
+	}
+	return e.out.printResult(resp)
+}
+
+// profilesExport 는 프로필을 백업 파일로 저장합니다. COOKIEBOT_PASSPHRASE 가 있으면 비밀 정보를 암호화합니다.
+func profilesExport(e *env, args []string) error {
+	if len(args) < 2 {
+		return usagef("usage: profiles export <file> <id>...")
+	}
+
+	bm := e.browser()
+	data, err := bm.ExportProfiles(args[1:], os.Getenv("COOKIEBOT_PASSPHRASE"))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(args[0], data, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", args[0], err)
+	}
+	return e.out.printResult(map[string]interface{}{"exported": len(args) - 1, "file": args[0]})
+}
+
+// profilesImport 는 백업 파일의 프로필을 만듭니다. 이름 충돌 시 기본 동작은 skip 입니다.
+func profilesImport(e *env, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return usagef("usage: profiles import <file> [skip|rename|overwrite]")
+	}
+	conflict := browser.ConflictSkip
+	if len(args) == 2 {
+		conflict = args[1]
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", args[0], err)
+	}
+
+	bm := e.browser()
+	results, err := bm.ImportProfiles(data, os.Getenv("COOKIEBOT_PASSPHRASE"), conflict)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(results))
+	failed := 0
+	for _, r := range results {
+		rows = append(rows, []string{r.Name, r.Action, r.ProfileID, r.Error})
+		if r.Action == "failed" {
+			failed++
+		}
+	}
+	if err := e.out.print(results, []string{"NAME", "ACTION", "ID", "ERROR"}, rows); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d profiles failed to import", failed, len(results))
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
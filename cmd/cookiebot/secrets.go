@@ -0,0 +1,50 @@
+// cmd/cookiebot/secrets.go
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// secretsSet 은 표준 입력의 첫 줄을 비밀 값으로 저장합니다. 값이 셸 기록에 남지 않도록 인자로 받지 않습니다.
+func secretsSet(e *env, args []string) error {
+	if len(args) != 1 {
+		return usagef("usage: secrets set <name> < value")
+	}
+
+	value, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && value == "" {
+		return fmt.Errorf("failed to read secret from stdin: %w", err)
+	}
+	value = strings.TrimRight(value, "\r\n")
+	if value == "" {
+		return usagef("secrets set: empty value on stdin")
+	}
+
+	store, err := e.secrets()
+	if err != nil {
+		return err
+	}
+	if err := store.Set(args[0], value); err != nil {
+		return fmt.Errorf("failed to store %s: %w", args[0], err)
+	}
+	return e.out.printResult(map[string]interface{}{"stored": args[0], "ref": "secret://" + args[0]})
+}
+
+func secretsDelete(e *env, args []string) error {
+	if len(args) != 1 {
+		return usagef("usage: secrets delete <name>")
+	}
+
+	store, err := e.secrets()
+	if err != nil {
+		return err
+	}
+	if err := store.Delete(args[0]); err != nil {
+		return fmt.Errorf("failed to delete %s: %w", args[0], err)
+	}
+	return e.out.printResult(map[string]interface{}{"deleted": args[0]})
+}
@@ -4,6 +4,7 @@ import React, { useState, useEffect } from 'react';
 import MainSidebar from './sidebar/MainSidebar';
 import GmailAccount from './pages/GmailAccount';
 import BrowserProfile from "./pages/BrowserProfile";
+import LockScreen from './components/LockScreen';
 import { Sun, Moon } from 'lucide-react';
 
 function App() {
@@ -61,6 +62,7 @@ function App() {
                 </button>
                 {renderContent()}
             </div>
+            <LockScreen />
         </div>
     );
 }
@@ -46,12 +46,12 @@ const AntiDetectStatus: React.FC<AntiDetectStatusProps> = ({
 
     const checkInitialStatus = async () => {
         try {
-            const installed = await window.go.antidetect.ADD.IsAntiDetectInstalled();
+            const installed = await window.go.app.AntiDetectService.IsInstalled();
             setIsInstalled(installed);
             if (installed) {
                 console.log("AntiDetect가 설치되어 있습니다. 실행을 시도합니다.");
                 try {
-                    await window.go.antidetect.ADD.RunAntiDetect();
+                    await window.go.app.AntiDetectService.Run();
                     console.log("AntiDetect를 성공적으로 실행했습니다.");
                     setIsRunning(true);
                 } catch (runError) {
@@ -74,14 +74,14 @@ const AntiDetectStatus: React.FC<AntiDetectStatusProps> = ({
             onStatusChange('다운로드 중...', 'blue', false);
 
             // AntiDetect 다운로드 및 설치
-            await window.go.antidetect.ADD.DownloadAndInstallAntiDetect();
+            await window.go.app.AntiDetectService.Install();
 
             // 다운로드 및 설치 완료
             setIsDownloading(false);
             setIsInstalling(false);
 
             // 설치 상태 확인
-            const installed = await window.go.antidetect.ADD.IsAntiDetectInstalled();
+            const installed = await window.go.app.AntiDetectService.IsInstalled();
             setIsInstalled(installed);
         } catch (error) {
             console.error("설치 시작 중 오류 발생:", error);
@@ -100,14 +100,14 @@ const AntiDetectStatus: React.FC<AntiDetectStatusProps> = ({
 
     const checkAndRunAntiDetect = async () => {
         try {
-            const installed = await window.go.antidetect.ADD.IsAntiDetectInstalled();
+            const installed = await window.go.app.AntiDetectService.IsInstalled();
             if (installed) {
-                const running = await window.go.antidetect.ADD.IsAntiDetectRunning();
+                const running = await window.go.app.AntiDetectService.IsRunning();
                 console.log("AntiDetect 실행 상태:", running);
 
                 if (!running) {
                     console.log("AntiDetect가 실행 중이 아닙니다. 실행을 시도합니다.");
-                    await window.go.antidetect.ADD.RunAntiDetect();
+                    await window.go.app.AntiDetectService.Run();
                     setIsRunning(true);
                 } else {
                     console.log("AntiDetect가 이미 실행 중입니다.");
@@ -129,7 +129,7 @@ const AntiDetectStatus: React.FC<AntiDetectStatusProps> = ({
 
     const handleRun = async () => {
         try {
-            await window.go.antidetect.ADD.RunAntiDetect();
+            await window.go.app.AntiDetectService.Run();
             setIsRunning(true);
             onStatusChange('AntiDetect가 실행 중입니다.', 'blue', true);
         } catch (error) {
@@ -0,0 +1,68 @@
+// frontend/src/components/HealthPanel.tsx
+
+import React, { useEffect, useState } from 'react';
+
+// 검사 이름별 표시 이름
+const CHECK_LABELS: { [name: string]: string } = {
+    vmware: 'VMware',
+    antidetect: '안티디텍트',
+    browser_api: '브라우저 API',
+    account_store: '계정 저장소',
+    disk_space: '디스크',
+    log: '로그',
+};
+
+const STATUS_COLORS: { [status: string]: string } = {
+    ok: 'bg-green-500',
+    warn: 'bg-yellow-400',
+    fail: 'bg-red-500',
+};
+
+const HealthPanel: React.FC = () => {
+    const [report, setReport] = useState<HealthReport | null>(null);
+
+    useEffect(() => {
+        window.go.app.HealthService.Last().then(setReport);
+        return window.runtime.EventsOn('health:changed', (next: HealthReport) => setReport(next));
+    }, []);
+
+    if (!report) {
+        return null;
+    }
+
+    return (
+        <div className="mb-6 space-y-1">
+            {report.checks.map(check => (
+                <div
+                    key={check.name}
+                    title={[check.message, check.hint].filter(Boolean).join('\n')}
+                    className="flex items-center text-xs text-gray-700 dark:text-gray-300"
+                >
+                    <span className={`w-2 h-2 rounded-full mr-2 ${STATUS_COLORS[check.status] ?? 'bg-gray-400'}`} />
+                    <span className="flex-grow">{CHECK_LABELS[check.name] ?? check.name}</span>
+                    <span className="text-gray-400">{check.latency_ms}ms</span>
+                </div>
+            ))}
+            <button
+                onClick={async () => setReport(await window.go.app.HealthService.Check())}
+                className="w-full mt-2 text-xs text-blue-500 hover:underline"
+            >
+                다시 검사
+            </button>
+            <button
+                onClick={async () => {
+                    try {
+                        await window.go.app.DiagnosticsService.CreateBundle();
+                    } catch (error) {
+                        console.error("Failed to create diagnostics bundle:", error);
+                    }
+                }}
+                className="w-full text-xs text-blue-500 hover:underline"
+            >
+                진단 번들 만들기
+            </button>
+        </div>
+    );
+};
+
+export default HealthPanel;
@@ -1,12 +1,9 @@
 // frontend/src/Hidemyacc.ts
 
+// HideMyAcc 로컬 API 는 Go 쪽 공급자(internal/browser/hidemyacc.go)를 통해 호출합니다.
 class Hidemyacc {
     async me() {
-        const response = await fetch('http://127.0.0.1:2268/me');
-        if (!response.ok) {
-            throw new Error('Network response was not ok');
-        }
-        return response.json();
+        return window.go.app.ProfileService.ProviderAccount('hidemyacc');
     }
 }
 
@@ -0,0 +1,102 @@
+// frontend/src/components/LockScreen.tsx
+
+import React, { useEffect, useRef, useState } from 'react';
+import { Lock } from 'lucide-react';
+import { errorMessage } from '../errors';
+
+// 사용자 활동을 백엔드에 알리는 최소 간격 (자동 잠금 타이머 갱신)
+const TOUCH_INTERVAL_MS = 30_000;
+
+const LockScreen: React.FC = () => {
+    const [status, setStatus] = useState<AppLockStatus | null>(null);
+    const [passphrase, setPassphrase] = useState('');
+    const [confirm, setConfirm] = useState('');
+    const [error, setError] = useState('');
+    const lastTouchRef = useRef(0);
+
+    useEffect(() => {
+        window.go.app.LockService.Status().then(setStatus);
+        return window.runtime.EventsOn('app:lock', (next: AppLockStatus) => setStatus(next));
+    }, []);
+
+    // 입력이 있으면 자동 잠금 시간을 늦춤
+    useEffect(() => {
+        const onActivity = () => {
+            const now = Date.now();
+            if (now - lastTouchRef.current > TOUCH_INTERVAL_MS) {
+                lastTouchRef.current = now;
+                window.go.app.LockService.Touch();
+            }
+        };
+        window.addEventListener('mousemove', onActivity);
+        window.addEventListener('keydown', onActivity);
+        return () => {
+            window.removeEventListener('mousemove', onActivity);
+            window.removeEventListener('keydown', onActivity);
+        };
+    }, []);
+
+    if (!status || (status.configured && !status.locked)) {
+        return null;
+    }
+
+    const isSetup = !status.configured;
+
+    const handleSubmit = async (e: React.FormEvent) => {
+        e.preventDefault();
+        setError('');
+        try {
+            if (isSetup) {
+                if (passphrase !== confirm) {
+                    setError('패스프레이즈가 일치하지 않습니다.');
+                    return;
+                }
+                await window.go.app.LockService.Setup(passphrase);
+            } else {
+                await window.go.app.LockService.Unlock(passphrase);
+            }
+            setPassphrase('');
+            setConfirm('');
+            setStatus(await window.go.app.LockService.Status());
+        } catch (err) {
+            setError(errorMessage(err));
+        }
+    };
+
+    return (
+        <div className="fixed inset-0 bg-gray-900 bg-opacity-95 flex justify-center items-center z-50">
+            <form onSubmit={handleSubmit} className="bg-white dark:bg-gray-800 p-6 rounded-lg shadow-xl w-80 space-y-4">
+                <div className="flex items-center justify-center text-gray-800 dark:text-gray-200">
+                    <Lock className="w-5 h-5 mr-2" />
+                    <h3 className="text-lg font-semibold">{isSetup ? '마스터 패스프레이즈 설정' : '잠금 해제'}</h3>
+                </div>
+                <input
+                    type="password"
+                    autoFocus
+                    value={passphrase}
+                    onChange={e => setPassphrase(e.target.value)}
+                    placeholder="마스터 패스프레이즈"
+                    className="w-full px-3 py-2 rounded border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-800 dark:text-gray-200"
+                />
+                {isSetup && (
+                    <input
+                        type="password"
+                        value={confirm}
+                        onChange={e => setConfirm(e.target.value)}
+                        placeholder="패스프레이즈 확인"
+                        className="w-full px-3 py-2 rounded border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-800 dark:text-gray-200"
+                    />
+                )}
+                {error && <div className="text-sm text-red-500">{error}</div>}
+                <button
+                    type="submit"
+                    className="w-full px-4 py-2 text-sm font-medium text-white bg-blue-500 hover:bg-blue-600 rounded-md transition-colors duration-200"
+                >
+                    {isSetup ? '설정' : '잠금 해제'}
+                </button>
+            </form>
+        </div>
+    );
+};
+
+export default LockScreen;
@@ -0,0 +1,34 @@
+// frontend/src/errors.ts
+
+// 백엔드 오류 코드 (internal/apperr)
+export const ErrorCodes = {
+    INTERNAL: 'INTERNAL',
+    INVALID_ARGUMENT: 'INVALID_ARGUMENT',
+    STORE_CONFLICT: 'STORE_CONFLICT',
+    INSTALL_CANCELLED: 'INSTALL_CANCELLED',
+    DOWNLOAD_CHECKSUM_MISMATCH: 'DOWNLOAD_CHECKSUM_MISMATCH',
+} as const;
+
+export function isAppError(err: unknown): err is AppError {
+    return typeof err === 'object' && err !== null && 'code' in err && 'message' in err;
+}
+
+// errorCode 는 백엔드 오류 코드를 반환합니다. 백엔드 오류가 아니면 undefined 입니다.
+export function errorCode(err: unknown): string | undefined {
+    return isAppError(err) ? err.code : undefined;
+}
+
+// errorMessage 는 사용자에게 보여 줄 문구를 반환합니다.
+// 입력 오류와 분류되지 않은 오류는 원인이 있어야 알 수 있으므로 함께 붙입니다.
+export function errorMessage(err: unknown): string {
+    if (isAppError(err)) {
+        if (err.cause && (err.code === ErrorCodes.INVALID_ARGUMENT || err.code === ErrorCodes.INTERNAL)) {
+            return `${err.message} (${err.cause})`;
+        }
+        return err.message;
+    }
+    if (err instanceof Error) {
+        return err.message;
+    }
+    return String(err);
+}
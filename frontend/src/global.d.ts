@@ -1,58 +1,178 @@
 interface Runtime {
     LogInfo(message: string): void;
     LogError(message: string): void;
+    EventsOn(eventName: string, callback: (...data: any) => void): () => void;
 }
 
 interface Window {
     runtime: Runtime;
     go: {
-        vm: {
-            VM: {
-                CheckVMWareStatus(): Promise<{ vmrun_exists: boolean; vmware_exists: boolean; vm_folder_exists: boolean; }>;
+        app: {
+            VMService: {
+                Status(): Promise<{ vmrun_exists: boolean; vmware_exists: boolean; vm_folder_exists: boolean; }>;
+                ListVirtualMachines(): Promise<Array<{ name: string; path: string; }>>;
+                Install(): Promise<void>;
+                InstallProgress(): Promise<number>;
             },
-            VMD: {
-                DownloadAndInstallVMWare(): Promise<void>;
-                GetInstallationProgress(): Promise<number>;
-            }
-        },
-        antidetect: {
-            ADD: {
-                DownloadAndInstallAntiDetect(): Promise<void>;
-                GetInstallationProgress(): Promise<number>;
-                CheckAndRunAntiDetect(): Promise<void>;
-                EnsureAntiDetectRunning(): Promise<void>;
-                IsAntiDetectInstalled(): Promise<boolean>;
-                IsAntiDetectRunning(): Promise<boolean>;
-                RunAntiDetect(): Promise<void>;
-            }
-        },
-        db: {
-            EmailDB: {
-                DeleteEmail(arg1: string): Promise<void>;
-                GetEmail(arg1: string): Promise<GmailAccount>;
-                ListEmails(): Promise<Array<GmailAccount>>;
-                SaveEmail(arg1: GmailAccount): Promise<void>;
-            }
-        },
-        browser: {
-            BrowserManager: {
-                AddProfile(arg1: string): Promise<void>;
-                FetchProfiles(): Promise<Array<string>>;
-                LaunchProfile(arg1: string): Promise<void>;
-                ModifyProfile(profileID: string, req: ModifyProfileRequest): Promise<any>;
-                RemoveProfile(arg1: string): Promise<void>;
-                StopProfile(arg1: string): Promise<void>;
-                FetchProfileInfo(profileID: string): Promise<ProfileInfoResponse>; // 추가된 부분
+            AntiDetectService: {
+                Install(): Promise<void>;
+                IsInstalled(): Promise<boolean>;
+                IsRunning(): Promise<boolean>;
+                Run(): Promise<void>;
+            },
+            AccountService: {
+                ListAccounts(): Promise<Array<GmailAccount>>;
+                GetAccount(email: string): Promise<GmailAccount>;
+                RevealPassword(email: string): Promise<string>;
+                CreateAccount(account: GmailAccount): Promise<GmailAccount>;
+                UpdateAccount(account: GmailAccount): Promise<GmailAccount>;
+                SetAccountUsed(email: string, used: boolean, version: number): Promise<GmailAccount>;
+                DeleteAccount(email: string): Promise<void>;
+                PreviewAccountImport(opts: AccountImportOptions): Promise<AccountImport | null>;
+                ImportAccounts(token: string, opts: AccountImportOptions): Promise<AccountImport>;
+            },
+            LockService: {
+                Status(): Promise<AppLockStatus>;
+                Setup(passphrase: string): Promise<void>;
+                Unlock(passphrase: string): Promise<void>;
+                Lock(): Promise<void>;
+                ChangePassphrase(current: string, next: string): Promise<void>;
+                SetIdleTimeout(seconds: number): Promise<void>;
+                Touch(): Promise<void>;
+            },
+            AuditService: {
+                QueryAudit(q: AuditQuery): Promise<Array<AuditEntry>>;
+                VerifyAudit(): Promise<number>;
+                ExportAudit(q: AuditQuery): Promise<string>;
+            },
+            DiagnosticsService: {
+                CreateBundle(): Promise<string>;
+            },
+            ErrorService: {
+                Languages(): Promise<Array<string>>;
+                Language(): Promise<string>;
+                SetLanguage(lang: string): Promise<void>;
+                Catalog(lang: string): Promise<{ [key: string]: string }>;
+            },
+            HealthService: {
+                Check(): Promise<HealthReport>;
+                Last(): Promise<HealthReport>;
+            },
+            ProfileService: {
+                ListProfiles(): Promise<ProfileResponse>;
+                APIState(): Promise<BrowserAPIState>;
+                Providers(): Promise<Array<BrowserProvider>>;
+                SetProvider(name: string): Promise<void>;
+                ProviderAccount(name: string): Promise<{ [key: string]: any }>;
+                GetProfile(profileID: string): Promise<ProfileInfoResponse>;
+                CreateProfile(req: ModifyProfileRequest): Promise<any>;
+                UpdateProfile(profileID: string, req: ModifyProfileRequest): Promise<any>;
+                StartProfile(profileID: string): Promise<any>;
+                StopProfile(profileID: string): Promise<any>;
+                DeleteProfile(profileID: string): Promise<any>;
             }
         }
     }
 }
 
+// 브라우저 API 회로 차단기 상태 ("closed" 가 아니면 재연결 중)
+type BrowserAPIState = 'closed' | 'open' | 'half-open';
+
+// 안티디텍트 브라우저 공급자 (undetectable, hidemyacc)
+interface BrowserProvider {
+    name: string;
+    active: boolean;
+    capabilities: {
+        update: boolean;
+        cookies: boolean;
+        folders: boolean;
+        tags: boolean;
+        debugger: boolean;
+    };
+}
+
+// 바인딩된 메서드가 reject 할 때 전달되는 오류 (code 는 안정적인 값, message 는 현지화된 문구)
+interface AppError {
+    code: string;
+    key: string;
+    params?: { [key: string]: string };
+    message: string;
+    cause?: string;
+}
+
+// 시스템 상태 검사 (status: ok, warn, fail)
+interface HealthReport {
+    status: string;
+    checked_at: string;
+    checks: Array<{
+        name: string;
+        status: string;
+        latency_ms: number;
+        message: string;
+        hint?: string;
+    }>;
+}
+
 interface GmailAccount {
     Email: string;
     Password: string;
     RecoveryEmail: string;
     Used: boolean;
+    Version: number;    // 수정 시 그대로 전달 (충돌 감지용)
+    CreatedAt: string;
+    UpdatedAt: string;
+}
+
+interface AppLockStatus {
+    configured: boolean;
+    locked: boolean;
+    idle_timeout_seconds: number;
+}
+
+interface AuditQuery {
+    operation?: string;   // 접두사 (예: "profile.")
+    target?: string;
+    outcome?: 'ok' | 'error';
+    since?: string;
+    until?: string;
+    limit?: number;
+}
+
+interface AuditEntry {
+    seq: number;
+    time: string;
+    user: string;
+    operation: string;
+    target?: string;
+    outcome: 'ok' | 'error';
+    error?: string;
+    params?: { [key: string]: string };
+    prev_hash: string;
+    hash: string;
+}
+
+interface AccountImportOptions {
+    format?: 'csv' | 'json';
+    delimiter?: string;
+    no_header?: boolean;
+    columns?: { [field: string]: string };
+}
+
+interface AccountImport {
+    token: string;
+    preview: {
+        rows: Array<{ line: number; account: GmailAccount; issues?: string[] }>;
+        valid: number;
+        invalid: number;
+        imported: number;
+        dry_run: boolean;
+    };
+}
+
+interface ProfileResponse {
+    code: number;
+    status: string;
+    data: { [profileID: string]: Profile };
 }
 
 // 수정된 ProfileInfoResponse 인터페이스
@@ -84,4 +204,8 @@ interface Profile {
     language: string;     // 추가된 필드
     cpu: number;          // 추가된 필드
     memory: number;       // 추가된 필드
+    geolocation?: string;
+    timezone?: string;
+    group?: string;
+    accounts?: Array<{ website: string; username: string; password: string; }>;
 }
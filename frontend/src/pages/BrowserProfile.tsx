@@ -4,6 +4,7 @@ import React, { useEffect, useState } from 'react';
 import { Play, StopCircle, Settings } from 'lucide-react';
 import IPInfo from '../components/IPInfo';
 import ProfileSettingsModal from './ProfileSettingsModal';
+import { errorMessage } from '../errors';
 
 interface Profile {
     id: string;
@@ -34,21 +35,34 @@ const BrowserProfile: React.FC = () => {
     const [error, setError] = useState<string | null>(null);
     const [selectedProfile, setSelectedProfile] = useState<Profile | null>(null);
     const [isSettingsModalOpen, setIsSettingsModalOpen] = useState(false);
+    const [providers, setProviders] = useState<BrowserProvider[]>([]);
 
     useEffect(() => {
         fetchProfiles();
+        window.go.app.ProfileService.Providers().then(setProviders);
     }, []);
 
+    const handleProviderChange = async (name: string) => {
+        try {
+            await window.go.app.ProfileService.SetProvider(name);
+            setProviders(await window.go.app.ProfileService.Providers());
+            fetchProfiles();
+        } catch (error) {
+            console.error("Failed to switch provider:", error);
+            setError(errorMessage(error));
+        }
+    };
+
     const fetchProfiles = async () => {
         setLoading(true);
         setError(null);
         try {
-            const response = await window.go.browser.BrowserManager.FetchProfiles() as unknown as ApiResponse;
+            const response = await window.go.app.ProfileService.ListProfiles() as unknown as ApiResponse;
 
             if (response && response.status === "success" && response.data) {
                 const profilesArray = await Promise.all(
                     Object.keys(response.data).map(async (profileId) => {
-                        const profileInfoResponse = await window.go.browser.BrowserManager.FetchProfileInfo(profileId) as unknown as ProfileInfoResponse;
+                        const profileInfoResponse = await window.go.app.ProfileService.GetProfile(profileId) as unknown as ProfileInfoResponse;
 
                         if (profileInfoResponse && profileInfoResponse.status === "success" && profileInfoResponse.data) {
                             const profileInfo = profileInfoResponse.data;
@@ -90,7 +104,7 @@ const BrowserProfile: React.FC = () => {
                 setError("Unexpected response format: " + JSON.stringify(response));
             }
         } catch (error) {
-            setError("Failed to fetch profiles: " + (error as Error).message);
+            setError("Failed to fetch profiles: " + errorMessage(error));
         } finally {
             setLoading(false);
         }
@@ -122,7 +136,7 @@ const BrowserProfile: React.FC = () => {
 
     const handleStopProfile = async (profileId: string) => {
         try {
-            await window.go.browser.BrowserManager.StopProfile(profileId);
+            await window.go.app.ProfileService.StopProfile(profileId);
             // 프로필 상태를 "stopped"로 업데이트
             setProfiles(profiles.map(profile =>
                 profile.id === profileId ? { ...profile, status: "stopped" } : profile
@@ -134,7 +148,7 @@ const BrowserProfile: React.FC = () => {
 
     const handleStartProfile = async (profileId: string) => {
         try {
-            await window.go.browser.BrowserManager.LaunchProfile(profileId);
+            await window.go.app.ProfileService.StartProfile(profileId);
             // 프로필 상태를 "running"으로 업데이트
             setProfiles(profiles.map(profile =>
                 profile.id === profileId ? { ...profile, status: "running" } : profile
@@ -156,6 +170,17 @@ const BrowserProfile: React.FC = () => {
         <div className="flex justify-center items-center min-h-screen bg-gray-100 dark:bg-gray-900 p-4">
             <div className="w-full max-w-5xl bg-white dark:bg-gray-800 rounded-lg shadow-lg overflow-hidden">
                 <div className="p-6">
+                    <div className="flex justify-end mb-4">
+                        <select
+                            value={providers.find(p => p.active)?.name ?? ''}
+                            onChange={e => handleProviderChange(e.target.value)}
+                            className="px-3 py-1 text-sm rounded border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-700 dark:text-gray-300"
+                        >
+                            {providers.map(p => (
+                                <option key={p.name} value={p.name}>{p.name}</option>
+                            ))}
+                        </select>
+                    </div>
                     <div className="overflow-x-auto">
                         <table className="w-full border-collapse text-sm">
                             <thead>
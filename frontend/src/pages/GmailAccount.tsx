@@ -1,12 +1,16 @@
 // frontend/src/pages/GmailAccount.tsx
 
 import React, { useEffect, useState } from 'react';
+import { errorCode, ErrorCodes } from '../errors';
 
 interface GmailAccount {
     Email: string;
     Password: string;
     RecoveryEmail: string;
     Used: boolean;
+    Version: number;
+    CreatedAt: string;
+    UpdatedAt: string;
 }
 
 const Modal: React.FC<{ isOpen: boolean; onClose: () => void; children: React.ReactNode }> = ({ isOpen, onClose, children }) => {
@@ -26,6 +30,9 @@ const GmailAccountPage: React.FC = () => {
     const [isDeleteModalOpen, setIsDeleteModalOpen] = useState(false);
     const [isCopyModalOpen, setIsCopyModalOpen] = useState(false);
     const [selectedEmail, setSelectedEmail] = useState('');
+    const [accountImport, setAccountImport] = useState<AccountImport | null>(null);
+    // 행별로 "보기" 를 눌러 확인한 비밀번호 (목록에는 가려진 값만 옴)
+    const [revealed, setRevealed] = useState<{ [email: string]: string }>({});
 
     useEffect(() => {
         fetchEmailAccounts();
@@ -33,8 +40,9 @@ const GmailAccountPage: React.FC = () => {
 
     const fetchEmailAccounts = async () => {
         try {
-            const accounts = await window.go.db.EmailDB.ListEmails();
+            const accounts = await window.go.app.AccountService.ListAccounts();
             setEmailAccounts(accounts);
+            setRevealed({});
         } catch (error) {
             console.error("Failed to fetch email accounts:", error);
         }
@@ -42,7 +50,7 @@ const GmailAccountPage: React.FC = () => {
 
     const handleDelete = async () => {
         try {
-            await window.go.db.EmailDB.DeleteEmail(selectedEmail);
+            await window.go.app.AccountService.DeleteAccount(selectedEmail);
             setIsDeleteModalOpen(false);
             fetchEmailAccounts();
         } catch (error) {
@@ -51,19 +59,59 @@ const GmailAccountPage: React.FC = () => {
     };
 
     const handleToggleUsed = async (email: string, used: boolean) => {
+        const version = emailAccounts.find(account => account.Email === email)?.Version ?? 0;
         try {
-            const account = await window.go.db.EmailDB.GetEmail(email);
-            account.Used = !used;
-            await window.go.db.EmailDB.SaveEmail(account);
+            await window.go.app.AccountService.SetAccountUsed(email, !used, version);
+        } catch (error) {
+            // 다른 사용자가 먼저 수정한 경우(conflict) 최신 상태를 다시 불러옵니다.
+            if (errorCode(error) !== ErrorCodes.STORE_CONFLICT) {
+                console.error("Failed to update email account:", error);
+            }
+        }
+        fetchEmailAccounts();
+    };
+
+    // 파일을 골라 검증 결과를 먼저 보여주고, 확인하면 문제가 없는 행만 저장
+    const handlePreviewImport = async () => {
+        try {
+            const result = await window.go.app.AccountService.PreviewAccountImport({});
+            if (result) {
+                setAccountImport(result);
+            }
+        } catch (error) {
+            console.error("Failed to preview account import:", error);
+        }
+    };
+
+    const handleConfirmImport = async () => {
+        if (!accountImport) return;
+        try {
+            const result = await window.go.app.AccountService.ImportAccounts(accountImport.token, {});
             fetchEmailAccounts();
+            // 저장에 실패한 행이 있으면 사유를 볼 수 있게 결과를 남겨 둡니다.
+            setAccountImport(result.preview.imported < result.preview.valid ? result : null);
+        } catch (error) {
+            console.error("Failed to import accounts:", error);
+        }
+    };
+
+    const handleToggleReveal = async (email: string) => {
+        if (revealed[email] !== undefined) {
+            const { [email]: _, ...rest } = revealed;
+            setRevealed(rest);
+            return;
+        }
+        try {
+            const password = await window.go.app.AccountService.RevealPassword(email);
+            setRevealed(prev => ({ ...prev, [email]: password }));
         } catch (error) {
-            console.error("Failed to update email account:", error);
+            console.error("Failed to reveal password:", error);
         }
     };
 
     const handleCopy = async (email: string) => {
         try {
-            const account = await window.go.db.EmailDB.GetEmail(email);
+            const account = await window.go.app.AccountService.GetAccount(email);
             navigator.clipboard.writeText(`${account.Email}:${account.Password}:${account.RecoveryEmail}`);
             setSelectedEmail(email);
             setIsCopyModalOpen(true);
@@ -76,6 +124,14 @@ const GmailAccountPage: React.FC = () => {
         <div className="flex justify-center items-center min-h-screen bg-gray-100 dark:bg-gray-900 p-4">
             <div className="w-full max-w-5xl bg-white dark:bg-gray-800 rounded-lg shadow-lg overflow-hidden">
                 <div className="p-6">
+                    <div className="flex justify-end mb-4">
+                        <button
+                            onClick={handlePreviewImport}
+                            className="px-4 py-2 text-sm font-medium text-white bg-blue-500 hover:bg-blue-600 rounded-md transition-colors duration-200"
+                        >
+                            가져오기
+                        </button>
+                    </div>
                     <div className="overflow-x-auto">
                         <table className="w-full border-collapse text-sm">
                             <thead>
@@ -92,7 +148,15 @@ const GmailAccountPage: React.FC = () => {
                             {emailAccounts.map((account) => (
                                 <tr key={account.Email} className="hover:bg-gray-50 dark:hover:bg-gray-700">
                                     <td className="py-3 px-4 border-b border-gray-200 dark:border-gray-700 text-gray-700 dark:text-gray-300 truncate">{account.Email}</td>
-                                    <td className="py-3 px-4 border-b border-gray-200 dark:border-gray-700 text-gray-700 dark:text-gray-300 truncate">{account.Password}</td>
+                                    <td className="py-3 px-4 border-b border-gray-200 dark:border-gray-700 text-gray-700 dark:text-gray-300 truncate">
+                                        {revealed[account.Email] ?? account.Password}
+                                        <button
+                                            onClick={() => handleToggleReveal(account.Email)}
+                                            className="ml-2 text-xs text-blue-500 hover:underline"
+                                        >
+                                            {revealed[account.Email] !== undefined ? '숨기기' : '보기'}
+                                        </button>
+                                    </td>
                                     <td className="py-3 px-4 border-b border-gray-200 dark:border-gray-700 text-gray-700 dark:text-gray-300 truncate">{account.RecoveryEmail}</td>
                                     <td className="py-3 px-4 border-b border-gray-200 dark:border-gray-700 text-center">
                                         <button
@@ -183,6 +247,38 @@ const GmailAccountPage: React.FC = () => {
                         </button>
                     </div>
                 </Modal>
+
+                <Modal isOpen={accountImport !== null} onClose={() => setAccountImport(null)}>
+                    <h3 className="text-lg font-semibold mb-4 text-gray-800 dark:text-gray-200">계정 가져오기 미리보기</h3>
+                    <p className="text-gray-600 dark:text-gray-400 mb-4">
+                        저장 가능 {accountImport?.preview.valid ?? 0}개, 문제 있음 {accountImport?.preview.invalid ?? 0}개<br />
+                        {accountImport?.preview.dry_run
+                            ? '문제가 있는 행은 저장되지 않습니다.'
+                            : `${accountImport?.preview.imported ?? 0}개를 저장했습니다.`}
+                    </p>
+                    <div className="max-h-60 overflow-y-auto mb-6 text-sm">
+                        {accountImport?.preview.rows.filter(row => row.issues?.length).map(row => (
+                            <div key={row.line} className="text-red-500">
+                                {row.line}행 {row.account.Email}: {row.issues?.join(', ')}
+                            </div>
+                        ))}
+                    </div>
+                    <div className="flex justify-center space-x-3">
+                        <button
+                            onClick={handleConfirmImport}
+                            disabled={!accountImport?.preview.valid || !accountImport?.preview.dry_run}
+                            className="px-4 py-2 text-sm font-medium text-white bg-blue-500 hover:bg-blue-600 disabled:bg-gray-300 rounded-md transition-colors duration-200"
+                        >
+                            저장
+                        </button>
+                        <button
+                            onClick={() => setAccountImport(null)}
+                            className="px-4 py-2 text-sm font-medium text-gray-700 bg-gray-100 hover:bg-gray-200 dark:text-gray-300 dark:bg-gray-700 dark:hover:bg-gray-600 rounded-md transition-colors duration-200"
+                        >
+                            취소
+                        </button>
+                    </div>
+                </Modal>
             </div>
         </div>
     );
@@ -54,7 +54,7 @@ const ProfileSettingsModal: React.FC<ProfileSettingsModalProps> = ({ isOpen, onC
             folder: folder || '',
             tags: tags.length > 0 ? tags : [],
             geolocation: geolocation || '',
-            cookies: cookies ? JSON.parse(cookies) : [{}], // JSON 문자열로 변환
+            cookies: cookies ? JSON.parse(cookies) : [], // JSON 문자열로 변환
             type: type || '',
             group: group || '',
             accounts: accounts.length > 0 ? accounts : [],
@@ -64,7 +64,7 @@ const ProfileSettingsModal: React.FC<ProfileSettingsModalProps> = ({ isOpen, onC
         console.log("업데이트할 데이터:", updateData, null, 2);
 
         try {
-            await window.go.browser.BrowserManager.ModifyProfile(profileID, updateData);
+            await window.go.app.ProfileService.UpdateProfile(profileID, updateData);
             onUpdate(updateData);
             onClose();
             console.log(JSON.stringify(updateData));
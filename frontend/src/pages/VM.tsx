@@ -2,6 +2,7 @@
 
 import React, { useState, useEffect, useMemo } from 'react';
 import './VM.css';
+import { errorCode, errorMessage, ErrorCodes } from '../errors';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
 import { faPlay, faPause, faStop, faCog } from '@fortawesome/free-solid-svg-icons';
 
@@ -43,7 +44,7 @@ function VM() {
         let interval: NodeJS.Timeout | null = null;
         if (isInstalling) {
             interval = setInterval(async () => {
-                const progress = await window.go.vm.VMD.GetInstallationProgress()
+                const progress = await window.go.app.VMService.InstallProgress()
                 setInstallationProgress(progress);
                 if (progress >= 100) {
                     clearInterval(interval!);
@@ -75,7 +76,7 @@ function VM() {
 
     const checkVMWareStatus = async () => {
         try {
-            const response = await window.go.vm.VM.CheckVMWareStatus();
+            const response = await window.go.app.VMService.Status();
             console.log("Response from CheckVMWareStatus:", response); // 응답 로그
             if (typeof response !== 'object' || response === null ||
                 typeof response.vmrun_exists !== 'boolean' ||
@@ -98,7 +99,7 @@ function VM() {
             }
         } catch (error) {
             console.error("VMWare 상태 확인 중 오류 발생:", error);
-            setErrorMessage("VMWare 상태 확인 중 오류가 발생했습니다: " + (error as Error).message);
+            setErrorMessage("VMWare 상태 확인 중 오류가 발생했습니다: " + errorMessage(error));
         }
     };
 
@@ -106,10 +107,13 @@ function VM() {
         setIsInstalling(true);
         setErrorMessage(null);
         try {
-            await window.go.vm.VMD.DownloadAndInstallVMWare();
+            await window.go.app.VMService.Install();
         } catch (error) {
             console.error("VMWare 설치 중 오류 발생:", error);
-            setErrorMessage(STATUS_MESSAGES.ERROR);
+            // 다운로드가 취소되었거나(앱 종료) 체크섬이 맞지 않으면 직접 설치 안내 대신 그 문구를 보여 줍니다.
+            const code = errorCode(error);
+            setErrorMessage(code === ErrorCodes.INSTALL_CANCELLED || code === ErrorCodes.DOWNLOAD_CHECKSUM_MISMATCH
+                ? errorMessage(error) : STATUS_MESSAGES.ERROR);
             setIsInstalling(false);
         }
     };
@@ -1,7 +1,7 @@
 // frontend\src\sidebar\AntiSidebar.tsx
 
-import React from 'react';
-import { Shield, Monitor, Mail, Menu, ShoppingCart } from 'lucide-react';
+import React, { useEffect, useState } from 'react';
+import { Shield, Monitor, Mail, Menu, ShoppingCart, RefreshCw } from 'lucide-react';
 
 interface AntiSidebarProps {
     isAntiDetect: boolean;
@@ -20,6 +20,14 @@ const AntiSidebar: React.FC<AntiSidebarProps> = ({
                                                      onMenuChange,
                                                      currentView
                                                  }) => {
+    const [apiState, setApiState] = useState<BrowserAPIState>('closed');
+
+    // 브라우저 API 가 재시작 중이면 오류 대신 "재연결 중" 을 표시
+    useEffect(() => {
+        window.go.app.ProfileService.APIState().then(setApiState).catch(() => {});
+        return window.runtime.EventsOn('browser:api-state', (state: BrowserAPIState) => setApiState(state));
+    }, []);
+
     return (
         <div className="w-64 bg-gray-100 dark:bg-gray-800 p-4 flex flex-col">
             <div className="flex items-center justify-between mb-4">
@@ -43,6 +51,12 @@ const AntiSidebar: React.FC<AntiSidebarProps> = ({
             <div className={`text-sm ${statusColor} mb-4`}>
                 {status}
             </div>
+            {apiState !== 'closed' && (
+                <div className="flex items-center text-sm text-yellow-600 mb-4">
+                    <RefreshCw className="w-4 h-4 mr-2 animate-spin" />
+                    재연결 중...
+                </div>
+            )}
             <div className="space-y-2">
                 <button
                     onClick={() => onMenuChange('status')}
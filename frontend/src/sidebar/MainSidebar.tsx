@@ -3,6 +3,7 @@
 import React from 'react';
 import { Mail, Globe, Menu } from 'lucide-react';
 import AntiDetectStatus from '../components/AntiDetectStatus';
+import HealthPanel from '../components/HealthPanel';
 
 interface MainSidebarProps {
     onStatusChange: (status: string, color: string, installed: boolean) => void;
@@ -33,6 +34,7 @@ const MainSidebar: React.FC<MainSidebarProps> = ({
                     />
                 </div>
             </div>
+            <HealthPanel />
             <div className="space-y-4 flex-grow">
                 <button
                     onClick={() => onMenuChange('profile')}
@@ -1,35 +0,0 @@
-// @ts-check
-// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
-// This file is automatically generated. DO NOT EDIT
-
-export function DownloadAndInstallAntiDetect() {
-  return window['go']['antidetect']['ADD']['DownloadAndInstallAntiDetect']();
-}
-
-export function EnsureAntiDetectRunning() {
-  return window['go']['antidetect']['ADD']['EnsureAntiDetectRunning']();
-}
-
-export function GetAntiDetectDestPath(arg1) {
-  return window['go']['antidetect']['ADD']['GetAntiDetectDestPath'](arg1);
-}
-
-export function GetAntiDetectDownloadURL(arg1) {
-  return window['go']['antidetect']['ADD']['GetAntiDetectDownloadURL'](arg1);
-}
-
-export function IsAntiDetectInstalled() {
-  return window['go']['antidetect']['ADD']['IsAntiDetectInstalled']();
-}
-
-export function IsAntiDetectRunning() {
-  return window['go']['antidetect']['ADD']['IsAntiDetectRunning']();
-}
-
-export function ReadConfig(arg1) {
-  return window['go']['antidetect']['ADD']['ReadConfig'](arg1);
-}
-
-export function RunAntiDetect() {
-  return window['go']['antidetect']['ADD']['RunAntiDetect']();
-}
@@ -0,0 +1,22 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {app} from '../models';
+import {db} from '../models';
+
+export function CreateAccount(arg1:db.GmailAccount):Promise<db.GmailAccount>;
+
+export function DeleteAccount(arg1:string):Promise<void>;
+
+export function GetAccount(arg1:string):Promise<db.GmailAccount>;
+
+export function ImportAccounts(arg1:string,arg2:db.ImportOptions):Promise<app.AccountImport>;
+
+export function ListAccounts():Promise<Array<db.GmailAccount>>;
+
+export function PreviewAccountImport(arg1:db.ImportOptions):Promise<app.AccountImport>;
+
+export function RevealPassword(arg1:string):Promise<string>;
+
+export function SetAccountUsed(arg1:string,arg2:boolean,arg3:number):Promise<db.GmailAccount>;
+
+export function UpdateAccount(arg1:db.GmailAccount):Promise<db.GmailAccount>;
@@ -0,0 +1,39 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function CreateAccount(arg1) {
+  return window['go']['app']['AccountService']['CreateAccount'](arg1);
+}
+
+export function DeleteAccount(arg1) {
+  return window['go']['app']['AccountService']['DeleteAccount'](arg1);
+}
+
+export function GetAccount(arg1) {
+  return window['go']['app']['AccountService']['GetAccount'](arg1);
+}
+
+export function ImportAccounts(arg1, arg2) {
+  return window['go']['app']['AccountService']['ImportAccounts'](arg1, arg2);
+}
+
+export function ListAccounts() {
+  return window['go']['app']['AccountService']['ListAccounts']();
+}
+
+export function PreviewAccountImport(arg1) {
+  return window['go']['app']['AccountService']['PreviewAccountImport'](arg1);
+}
+
+export function RevealPassword(arg1) {
+  return window['go']['app']['AccountService']['RevealPassword'](arg1);
+}
+
+export function SetAccountUsed(arg1, arg2, arg3) {
+  return window['go']['app']['AccountService']['SetAccountUsed'](arg1, arg2, arg3);
+}
+
+export function UpdateAccount(arg1) {
+  return window['go']['app']['AccountService']['UpdateAccount'](arg1);
+}
@@ -0,0 +1,10 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Install():Promise<void>;
+
+export function IsInstalled():Promise<boolean>;
+
+export function IsRunning():Promise<boolean>;
+
+export function Run():Promise<void>;
@@ -0,0 +1,19 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Install() {
+  return window['go']['app']['AntiDetectService']['Install']();
+}
+
+export function IsInstalled() {
+  return window['go']['app']['AntiDetectService']['IsInstalled']();
+}
+
+export function IsRunning() {
+  return window['go']['app']['AntiDetectService']['IsRunning']();
+}
+
+export function Run() {
+  return window['go']['app']['AntiDetectService']['Run']();
+}
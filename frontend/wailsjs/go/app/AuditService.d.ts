@@ -0,0 +1,9 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {audit} from '../models';
+
+export function ExportAudit(arg1:audit.Query):Promise<string>;
+
+export function QueryAudit(arg1:audit.Query):Promise<Array<audit.Entry>>;
+
+export function VerifyAudit():Promise<number>;
@@ -0,0 +1,15 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function ExportAudit(arg1) {
+  return window['go']['app']['AuditService']['ExportAudit'](arg1);
+}
+
+export function QueryAudit(arg1) {
+  return window['go']['app']['AuditService']['QueryAudit'](arg1);
+}
+
+export function VerifyAudit() {
+  return window['go']['app']['AuditService']['VerifyAudit']();
+}
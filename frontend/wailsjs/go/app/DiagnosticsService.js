@@ -2,6 +2,6 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
-export function CheckVMWareStatus() {
-  return window['go']['vm']['VM']['CheckVMWareStatus']();
+export function CreateBundle() {
+  return window['go']['app']['DiagnosticsService']['CreateBundle']();
 }
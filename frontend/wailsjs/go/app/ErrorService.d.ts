@@ -0,0 +1,10 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Catalog(arg1:string):Promise<{[key: string]: string}>;
+
+export function Language():Promise<string>;
+
+export function Languages():Promise<Array<string>>;
+
+export function SetLanguage(arg1:string):Promise<void>;
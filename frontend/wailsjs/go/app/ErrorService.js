@@ -0,0 +1,19 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Catalog(arg1) {
+  return window['go']['app']['ErrorService']['Catalog'](arg1);
+}
+
+export function Language() {
+  return window['go']['app']['ErrorService']['Language']();
+}
+
+export function Languages() {
+  return window['go']['app']['ErrorService']['Languages']();
+}
+
+export function SetLanguage(arg1) {
+  return window['go']['app']['ErrorService']['SetLanguage'](arg1);
+}
@@ -0,0 +1,17 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {applock} from '../models';
+
+export function ChangePassphrase(arg1:string,arg2:string):Promise<void>;
+
+export function Lock():Promise<void>;
+
+export function SetIdleTimeout(arg1:number):Promise<void>;
+
+export function Setup(arg1:string):Promise<void>;
+
+export function Status():Promise<applock.Status>;
+
+export function Touch():Promise<void>;
+
+export function Unlock(arg1:string):Promise<void>;
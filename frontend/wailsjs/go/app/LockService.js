@@ -0,0 +1,31 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function ChangePassphrase(arg1, arg2) {
+  return window['go']['app']['LockService']['ChangePassphrase'](arg1, arg2);
+}
+
+export function Lock() {
+  return window['go']['app']['LockService']['Lock']();
+}
+
+export function SetIdleTimeout(arg1) {
+  return window['go']['app']['LockService']['SetIdleTimeout'](arg1);
+}
+
+export function Setup(arg1) {
+  return window['go']['app']['LockService']['Setup'](arg1);
+}
+
+export function Status() {
+  return window['go']['app']['LockService']['Status']();
+}
+
+export function Touch() {
+  return window['go']['app']['LockService']['Touch']();
+}
+
+export function Unlock(arg1) {
+  return window['go']['app']['LockService']['Unlock'](arg1);
+}
@@ -0,0 +1,53 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {browser} from '../models';
+
+export function APIState():Promise<string>;
+
+export function AddTags(arg1:Array<string>,arg2:Array<string>):Promise<Array<browser.ProfileUpdateResult>>;
+
+export function CancelBulkOperations():Promise<void>;
+
+export function CreateProfile(arg1:browser.CreateProfileRequest):Promise<{[key: string]: any}>;
+
+export function DeleteProfile(arg1:string):Promise<{[key: string]: any}>;
+
+export function DeleteProfiles(arg1:Array<string>,arg2:number):Promise<Array<browser.BulkResult>>;
+
+export function ExportCookies(arg1:string,arg2:string):Promise<string>;
+
+export function ExportProfiles(arg1:Array<string>,arg2:string):Promise<string>;
+
+export function GetProfile(arg1:string):Promise<browser.ProfileInfoResponse>;
+
+export function ImportCookies(arg1:string):Promise<number>;
+
+export function ImportProfiles(arg1:string,arg2:string):Promise<Array<browser.ImportResult>>;
+
+export function ListFoldersAndTags():Promise<browser.ProfileTaxonomy>;
+
+export function ListProfiles():Promise<browser.ProfileResponse>;
+
+export function MoveProfiles(arg1:Array<string>,arg2:string):Promise<Array<browser.ProfileUpdateResult>>;
+
+export function ProviderAccount(arg1:string):Promise<{[key: string]: any}>;
+
+export function Providers():Promise<Array<browser.ProviderInfo>>;
+
+export function QueryProfiles(arg1:browser.ProfileQuery):Promise<browser.ProfilePage>;
+
+export function RemoveTags(arg1:Array<string>,arg2:Array<string>):Promise<Array<browser.ProfileUpdateResult>>;
+
+export function RenameTag(arg1:string,arg2:string):Promise<Array<browser.ProfileUpdateResult>>;
+
+export function SetProvider(arg1:string):Promise<void>;
+
+export function StartProfile(arg1:string):Promise<{[key: string]: any}>;
+
+export function StartProfiles(arg1:Array<string>,arg2:number):Promise<Array<browser.BulkResult>>;
+
+export function StopProfile(arg1:string):Promise<{[key: string]: any}>;
+
+export function StopProfiles(arg1:Array<string>,arg2:number):Promise<Array<browser.BulkResult>>;
+
+export function UpdateProfile(arg1:string,arg2:browser.CreateProfileRequest):Promise<{[key: string]: any}>;
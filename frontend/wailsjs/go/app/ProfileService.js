@@ -0,0 +1,103 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function APIState() {
+  return window['go']['app']['ProfileService']['APIState']();
+}
+
+export function AddTags(arg1, arg2) {
+  return window['go']['app']['ProfileService']['AddTags'](arg1, arg2);
+}
+
+export function CancelBulkOperations() {
+  return window['go']['app']['ProfileService']['CancelBulkOperations']();
+}
+
+export function CreateProfile(arg1) {
+  return window['go']['app']['ProfileService']['CreateProfile'](arg1);
+}
+
+export function DeleteProfile(arg1) {
+  return window['go']['app']['ProfileService']['DeleteProfile'](arg1);
+}
+
+export function DeleteProfiles(arg1, arg2) {
+  return window['go']['app']['ProfileService']['DeleteProfiles'](arg1, arg2);
+}
+
+export function ExportCookies(arg1, arg2) {
+  return window['go']['app']['ProfileService']['ExportCookies'](arg1, arg2);
+}
+
+export function ExportProfiles(arg1, arg2) {
+  return window['go']['app']['ProfileService']['ExportProfiles'](arg1, arg2);
+}
+
+export function GetProfile(arg1) {
+  return window['go']['app']['ProfileService']['GetProfile'](arg1);
+}
+
+export function ImportCookies(arg1) {
+  return window['go']['app']['ProfileService']['ImportCookies'](arg1);
+}
+
+export function ImportProfiles(arg1, arg2) {
+  return window['go']['app']['ProfileService']['ImportProfiles'](arg1, arg2);
+}
+
+export function ListFoldersAndTags() {
+  return window['go']['app']['ProfileService']['ListFoldersAndTags']();
+}
+
+export function ListProfiles() {
+  return window['go']['app']['ProfileService']['ListProfiles']();
+}
+
+export function MoveProfiles(arg1, arg2) {
+  return window['go']['app']['ProfileService']['MoveProfiles'](arg1, arg2);
+}
+
+export function ProviderAccount(arg1) {
+  return window['go']['app']['ProfileService']['ProviderAccount'](arg1);
+}
+
+export function Providers() {
+  return window['go']['app']['ProfileService']['Providers']();
+}
+
+export function QueryProfiles(arg1) {
+  return window['go']['app']['ProfileService']['QueryProfiles'](arg1);
+}
+
+export function RemoveTags(arg1, arg2) {
+  return window['go']['app']['ProfileService']['RemoveTags'](arg1, arg2);
+}
+
+export function RenameTag(arg1, arg2) {
+  return window['go']['app']['ProfileService']['RenameTag'](arg1, arg2);
+}
+
+export function SetProvider(arg1) {
+  return window['go']['app']['ProfileService']['SetProvider'](arg1);
+}
+
+export function StartProfile(arg1) {
+  return window['go']['app']['ProfileService']['StartProfile'](arg1);
+}
+
+export function StartProfiles(arg1, arg2) {
+  return window['go']['app']['ProfileService']['StartProfiles'](arg1, arg2);
+}
+
+export function StopProfile(arg1) {
+  return window['go']['app']['ProfileService']['StopProfile'](arg1);
+}
+
+export function StopProfiles(arg1, arg2) {
+  return window['go']['app']['ProfileService']['StopProfiles'](arg1, arg2);
+}
+
+export function UpdateProfile(arg1, arg2) {
+  return window['go']['app']['ProfileService']['UpdateProfile'](arg1, arg2);
+}
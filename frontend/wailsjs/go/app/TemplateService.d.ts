@@ -0,0 +1,13 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {browser} from '../models';
+
+export function CreateFromTemplate(arg1:string,arg2:number,arg3:browser.CreateProfileRequest):Promise<Array<browser.TemplateCreateResult>>;
+
+export function DeleteTemplate(arg1:string):Promise<void>;
+
+export function GetTemplate(arg1:string):Promise<browser.ProfileTemplate>;
+
+export function ListTemplates():Promise<Array<browser.ProfileTemplate>>;
+
+export function SaveTemplate(arg1:browser.ProfileTemplate):Promise<void>;
@@ -0,0 +1,23 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function CreateFromTemplate(arg1, arg2, arg3) {
+  return window['go']['app']['TemplateService']['CreateFromTemplate'](arg1, arg2, arg3);
+}
+
+export function DeleteTemplate(arg1) {
+  return window['go']['app']['TemplateService']['DeleteTemplate'](arg1);
+}
+
+export function GetTemplate(arg1) {
+  return window['go']['app']['TemplateService']['GetTemplate'](arg1);
+}
+
+export function ListTemplates() {
+  return window['go']['app']['TemplateService']['ListTemplates']();
+}
+
+export function SaveTemplate(arg1) {
+  return window['go']['app']['TemplateService']['SaveTemplate'](arg1);
+}
@@ -0,0 +1,11 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {vm} from '../models';
+
+export function Install():Promise<void>;
+
+export function InstallProgress():Promise<number>;
+
+export function ListVirtualMachines():Promise<Array<vm.VirtualMachine>>;
+
+export function Status():Promise<vm.VMWareStatus>;
@@ -0,0 +1,19 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Install() {
+  return window['go']['app']['VMService']['Install']();
+}
+
+export function InstallProgress() {
+  return window['go']['app']['VMService']['InstallProgress']();
+}
+
+export function ListVirtualMachines() {
+  return window['go']['app']['VMService']['ListVirtualMachines']();
+}
+
+export function Status() {
+  return window['go']['app']['VMService']['Status']();
+}
@@ -1,3 +1,118 @@
+export namespace app {
+	
+	export class AccountImport {
+	    token: string;
+	    preview: db.ImportPreview;
+	
+	    static createFrom(source: any = {}) {
+	        return new AccountImport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.token = source["token"];
+	        this.preview = this.convertValues(source["preview"], db.ImportPreview);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace applock {
+	
+	export class Status {
+	    configured: boolean;
+	    locked: boolean;
+	    idle_timeout_seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Status(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.configured = source["configured"];
+	        this.locked = source["locked"];
+	        this.idle_timeout_seconds = source["idle_timeout_seconds"];
+	    }
+	}
+
+}
+
+export namespace audit {
+	
+	export class Entry {
+	    seq: number;
+	    time: any;
+	    user: string;
+	    operation: string;
+	    target: string;
+	    outcome: string;
+	    error: string;
+	    params: {[key: string]: string};
+	    prev_hash: string;
+	    hash: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Entry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.seq = source["seq"];
+	        this.time = source["time"];
+	        this.user = source["user"];
+	        this.operation = source["operation"];
+	        this.target = source["target"];
+	        this.outcome = source["outcome"];
+	        this.error = source["error"];
+	        this.params = source["params"];
+	        this.prev_hash = source["prev_hash"];
+	        this.hash = source["hash"];
+	    }
+	}
+	export class Query {
+	    operation: string;
+	    target: string;
+	    outcome: string;
+	    since: any;
+	    until: any;
+	    limit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Query(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.operation = source["operation"];
+	        this.target = source["target"];
+	        this.outcome = source["outcome"];
+	        this.since = source["since"];
+	        this.until = source["until"];
+	        this.limit = source["limit"];
+	    }
+	}
+
+}
+
 export namespace browser {
 	
 	export class Account {
@@ -16,9 +131,55 @@ export namespace browser {
 	        this.password = source["password"];
 	    }
 	}
+	export class BulkResult {
+	    profile_id: string;
+	    ok: boolean;
+	    error: string;
+	    response: {[key: string]: any};
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.profile_id = source["profile_id"];
+	        this.ok = source["ok"];
+	        this.error = source["error"];
+	        this.response = source["response"];
+	    }
+	}
+	export class Capabilities {
+	    update: boolean;
+	    cookies: boolean;
+	    folders: boolean;
+	    tags: boolean;
+	    debugger: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Capabilities(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.update = source["update"];
+	        this.cookies = source["cookies"];
+	        this.folders = source["folders"];
+	        this.tags = source["tags"];
+	        this.debugger = source["debugger"];
+	    }
+	}
 	export class Cookie {
 	    name: string;
 	    value: string;
+	    domain: string;
+	    path: string;
+	    expirationDate: number;
+	    hostOnly: boolean;
+	    httpOnly: boolean;
+	    secure: boolean;
+	    session: boolean;
+	    sameSite: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new Cookie(source);
@@ -28,6 +189,14 @@ export namespace browser {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.name = source["name"];
 	        this.value = source["value"];
+	        this.domain = source["domain"];
+	        this.path = source["path"];
+	        this.expirationDate = source["expirationDate"];
+	        this.hostOnly = source["hostOnly"];
+	        this.httpOnly = source["httpOnly"];
+	        this.secure = source["secure"];
+	        this.session = source["session"];
+	        this.sameSite = source["sameSite"];
 	    }
 	}
 	export class CreateProfileRequest {
@@ -94,7 +263,40 @@ export namespace browser {
 		    return a;
 		}
 	}
+	export class ImportResult {
+	    name: string;
+	    profile_id: string;
+	    action: string;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.profile_id = source["profile_id"];
+	        this.action = source["action"];
+	        this.error = source["error"];
+	    }
+	}
+	export class NameCount {
+	    name: string;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new NameCount(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.count = source["count"];
+	    }
+	}
 	export class Profile {
+	    id: string;
 	    name: string;
 	    status: string;
 	    debug_port: string;
@@ -115,6 +317,10 @@ export namespace browser {
 	    language: string;
 	    cpu: number;
 	    memory: number;
+	    geolocation: string;
+	    timezone: string;
+	    group: string;
+	    accounts: Account[];
 	
 	    static createFrom(source: any = {}) {
 	        return new Profile(source);
@@ -122,6 +328,7 @@ export namespace browser {
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
 	        this.name = source["name"];
 	        this.status = source["status"];
 	        this.debug_port = source["debug_port"];
@@ -142,7 +349,29 @@ export namespace browser {
 	        this.language = source["language"];
 	        this.cpu = source["cpu"];
 	        this.memory = source["memory"];
+	        this.geolocation = source["geolocation"];
+	        this.timezone = source["timezone"];
+	        this.group = source["group"];
+	        this.accounts = this.convertValues(source["accounts"], Account);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class ProfileInfoResponse {
 	    code: number;
@@ -178,6 +407,68 @@ export namespace browser {
 		    return a;
 		}
 	}
+	export class ProfilePage {
+	    total: number;
+	    profiles: Profile[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfilePage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total = source["total"];
+	        this.profiles = this.convertValues(source["profiles"], Profile);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProfileQuery {
+	    status: string;
+	    folder: string;
+	    tags: string[];
+	    browser: string;
+	    os: string;
+	    search: string;
+	    sort_by: string;
+	    desc: boolean;
+	    offset: number;
+	    limit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.status = source["status"];
+	        this.folder = source["folder"];
+	        this.tags = source["tags"];
+	        this.browser = source["browser"];
+	        this.os = source["os"];
+	        this.search = source["search"];
+	        this.sort_by = source["sort_by"];
+	        this.desc = source["desc"];
+	        this.offset = source["offset"];
+	        this.limit = source["limit"];
+	    }
+	}
 	export class ProfileResponse {
 	    code: number;
 	    status: string;
@@ -212,13 +503,155 @@ export namespace browser {
 		    return a;
 		}
 	}
+	export class ProfileTaxonomy {
+	    folders: NameCount[];
+	    tags: NameCount[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileTaxonomy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.folders = this.convertValues(source["folders"], NameCount);
+	        this.tags = this.convertValues(source["tags"], NameCount);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProfileTemplate {
+	    name: string;
+	    name_pattern: string;
+	    profile: CreateProfileRequest;
+	    updated_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.name_pattern = source["name_pattern"];
+	        this.profile = this.convertValues(source["profile"], CreateProfileRequest);
+	        this.updated_at = source["updated_at"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProfileUpdateResult {
+	    profile_id: string;
+	    name: string;
+	    changed: boolean;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileUpdateResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.profile_id = source["profile_id"];
+	        this.name = source["name"];
+	        this.changed = source["changed"];
+	        this.error = source["error"];
+	    }
+	}
+	export class ProviderInfo {
+	    name: string;
+	    active: boolean;
+	    capabilities: Capabilities;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProviderInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.active = source["active"];
+	        this.capabilities = this.convertValues(source["capabilities"], Capabilities);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TemplateCreateResult {
+	    name: string;
+	    profile_id: string;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TemplateCreateResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.profile_id = source["profile_id"];
+	        this.error = source["error"];
+	    }
+	}
 
 }
 
 export namespace db {
 	
 	export class GmailAccount {
-	
+	    Email: string;
+	    Password: string;
+	    RecoveryEmail: string;
+	    Used: boolean;
+	    Version: number;
+	    CreatedAt: any;
+	    UpdatedAt: any;
 	
 	    static createFrom(source: any = {}) {
 	        return new GmailAccount(source);
@@ -226,7 +659,162 @@ export namespace db {
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Email = source["Email"];
+	        this.Password = source["Password"];
+	        this.RecoveryEmail = source["RecoveryEmail"];
+	        this.Used = source["Used"];
+	        this.Version = source["Version"];
+	        this.CreatedAt = source["CreatedAt"];
+	        this.UpdatedAt = source["UpdatedAt"];
+	    }
+	}
+	export class ImportOptions {
+	    format: string;
+	    delimiter: string;
+	    no_header: boolean;
+	    columns: {[key: string]: string};
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.format = source["format"];
+	        this.delimiter = source["delimiter"];
+	        this.no_header = source["no_header"];
+	        this.columns = source["columns"];
+	    }
+	}
+	export class ImportPreview {
+	    rows: ImportRow[];
+	    valid: number;
+	    invalid: number;
+	    imported: number;
+	    dry_run: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportPreview(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.rows = this.convertValues(source["rows"], ImportRow);
+	        this.valid = source["valid"];
+	        this.invalid = source["invalid"];
+	        this.imported = source["imported"];
+	        this.dry_run = source["dry_run"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ImportRow {
+	    line: number;
+	    account: GmailAccount;
+	    issues: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportRow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.line = source["line"];
+	        this.account = this.convertValues(source["account"], GmailAccount);
+	        this.issues = source["issues"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace health {
+	
+	export class Report {
+	    status: string;
+	    checked_at: any;
+	    checks: Result[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Report(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.status = source["status"];
+	        this.checked_at = source["checked_at"];
+	        this.checks = this.convertValues(source["checks"], Result);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Result {
+	    name: string;
+	    status: string;
+	    latency_ms: number;
+	    message: string;
+	    hint: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Result(source);
+	    }
 	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.latency_ms = source["latency_ms"];
+	        this.message = source["message"];
+	        this.hint = source["hint"];
 	    }
 	}
 
@@ -250,6 +838,20 @@ export namespace vm {
 	        this.vm_folder_exists = source["vm_folder_exists"];
 	    }
 	}
+	export class VirtualMachine {
+	    name: string;
+	    path: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new VirtualMachine(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.path = source["path"];
+	    }
+	}
 
 }
 
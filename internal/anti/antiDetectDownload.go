@@ -13,28 +13,42 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
-	"syscall"
+
+	"cookieBot/internal/apperr"
+	"cookieBot/internal/audit"
+	"cookieBot/utils"
 
 	"go.uber.org/zap"
-	"golang.org/x/sys/windows"
 )
 
 const (
 	downloadURL = "https://cdn.undetectable.io/download/Undetectable_x64_win.exe"
 	installPath = `C:\Program Files\Undetectable\Undetectable.exe`
 	exeFileName = "Undetectable.exe"
+	// 오류 메시지의 {component} 값
+	componentName = "Undetectable"
 )
 
+// installerSHA256 은 downloadURL 에서 받는 설치 파일의 SHA-256 입니다. 이 주소는 항상 최신 버전을 주므로
+// 배포할 때 -ldflags "-X cookieBot/internal/anti.installerSHA256=<hex>" 로 고정합니다. 비어 있으면 확인을 건너뜁니다.
+var installerSHA256 = ""
+
 type ADD struct {
 	ctx    context.Context
 	logger *zap.Logger
 	mu     sync.Mutex
+	audit  *audit.Log
 }
 
 func AntiDetectDownload(ctx context.Context, logger *zap.Logger) *ADD {
 	return &ADD{ctx: ctx, logger: logger}
 }
 
+// SetAuditLog 는 설치 작업을 기록할 감사 로그를 지정합니다.
+func (a *ADD) SetAuditLog(l *audit.Log) {
+	a.audit = l
+}
+
 func (a *ADD) IsAntiDetectInstalled() (bool, error) {
 	exists, err := pathExists(installPath)
 	if err != nil {
@@ -45,10 +59,15 @@ func (a *ADD) IsAntiDetectInstalled() (bool, error) {
 	return exists, nil
 }
 
+// InstalledVersion 은 설치된 Undetectable 의 파일 버전을 반환합니다.
+func (a *ADD) InstalledVersion() (string, error) {
+	return utils.FileVersion(installPath)
+}
+
 func (a *ADD) RunAntiDetect() error {
 	isInstalled, err := a.IsAntiDetectInstalled()
 	if err != nil {
-		return fmt.Errorf("설치 여부 확인 중 오류 발생: %w", err)
+		return componentError(apperr.InstallCheckFailed, err)
 	}
 
 	if !isInstalled {
@@ -58,18 +77,16 @@ func (a *ADD) RunAntiDetect() error {
 
 	isRunning, err := a.IsAntiDetectRunning()
 	if err != nil {
-		return fmt.Errorf("실행 상태 확인 중 오류 발생: %w", err)
+		return componentError(apperr.InstallCheckFailed, err)
 	}
 
 	if !isRunning {
 		cmd := exec.Command(installPath)
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			CreationFlags: windows.CREATE_NEW_PROCESS_GROUP | windows.DETACHED_PROCESS | windows.CREATE_NO_WINDOW,
-		}
+		detachProcess(cmd)
 
 		if err := cmd.Start(); err != nil {
 			a.logger.Error("Failed to start Undetectable", zap.Error(err))
-			return err
+			return componentError(apperr.LaunchFailed, err)
 		}
 
 		a.logger.Info("Undetectable started successfully in background")
@@ -93,6 +110,11 @@ func (a *ADD) IsAntiDetectRunning() (bool, error) {
 	return isRunning, nil
 }
 
+// componentError 는 Undetectable 설치/실행 오류를 code 로 감쌉니다.
+func componentError(code apperr.Code, err error) error {
+	return apperr.Wrap(code, err).With("component", componentName)
+}
+
 func pathExists(path string) (bool, error) {
 	_, err := os.Stat(path)
 	if err == nil {
@@ -104,18 +126,30 @@ func pathExists(path string) (bool, error) {
 	return false, err
 }
 
-func (a *ADD) DownloadAndInstallAntiDetect() error {
+func (a *ADD) DownloadAndInstallAntiDetect() (err error) {
+	defer func() {
+		a.audit.Record("install.antidetect", exeFileName, map[string]interface{}{"url": downloadURL}, err)
+	}()
+
 	tempDir := os.TempDir()
 	filePath := filepath.Join(tempDir, exeFileName)
 
 	a.logger.Info("Starting Undetectable download", zap.String("url", downloadURL), zap.String("filePath", filePath))
 
 	if err := a.downloadFile(filePath, downloadURL); err != nil {
-		return err
+		// 앱 종료로 ctx 가 취소되었으면 실패가 아니라 취소입니다.
+		if a.ctx.Err() != nil {
+			return componentError(apperr.InstallCancelled, err)
+		}
+		return componentError(apperr.DownloadFailed, err)
+	}
+	if err := a.verifyDownload(filePath); err != nil {
+		a.logger.Error("Undetectable download failed checksum verification", zap.Error(err))
+		return componentError(apperr.DownloadChecksumMismatch, err)
 	}
 
 	if err := a.runInstaller(filePath); err != nil {
-		return err
+		return componentError(apperr.InstallFailed, err)
 	}
 
 	return a.checkInstallation()
@@ -142,6 +176,10 @@ func (a *ADD) downloadFile(filePath, url string) error {
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		a.logger.Error("Unexpected download response", zap.String("status", resp.Status))
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
 
 	_, err = io.Copy(out, resp.Body)
 	if err != nil {
@@ -153,6 +191,19 @@ func (a *ADD) downloadFile(filePath, url string) error {
 	return nil
 }
 
+// verifyDownload 는 내려받은 설치 파일을 installerSHA256 과 비교하고, 다르면 실행하지 않도록 지웁니다.
+func (a *ADD) verifyDownload(filePath string) error {
+	if installerSHA256 == "" {
+		a.logger.Warn("No pinned SHA-256 for the Undetectable installer, skipping verification")
+		return nil
+	}
+	if err := utils.VerifySHA256(filePath, installerSHA256); err != nil {
+		os.Remove(filePath)
+		return err
+	}
+	return nil
+}
+
 func (a *ADD) runInstaller(filePath string) error {
 	cmd := exec.Command(filePath)
 	if err := cmd.Start(); err != nil {
@@ -172,6 +223,16 @@ func (a *ADD) checkInstallation() error {
 	return nil
 }
 
+// Cleanup removes the downloaded installer from the temp directory.
+func (a *ADD) Cleanup() error {
+	filePath := filepath.Join(os.TempDir(), exeFileName)
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		a.logger.Warn("Failed to remove Undetectable installer", zap.String("filePath", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (a *ADD) EnsureAntiDetectRunning() error {
 	isInstalled, err := a.IsAntiDetectInstalled()
 	if err != nil {
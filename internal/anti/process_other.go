@@ -0,0 +1,10 @@
+// internal/anti/process_other.go
+
+//go:build !windows
+
+package antidetect
+
+import "os/exec"
+
+// detachProcess 는 Windows 외 플랫폼에서는 아무 것도 하지 않습니다.
+func detachProcess(cmd *exec.Cmd) {}
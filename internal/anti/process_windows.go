@@ -0,0 +1,17 @@
+// internal/anti/process_windows.go
+
+package antidetect
+
+import (
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// detachProcess 는 Undetectable 을 콘솔 창 없이 별도 프로세스 그룹으로 실행하도록 설정합니다.
+func detachProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CreationFlags: windows.CREATE_NEW_PROCESS_GROUP | windows.DETACHED_PROCESS | windows.CREATE_NO_WINDOW,
+	}
+}
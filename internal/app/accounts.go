@@ -0,0 +1,255 @@
+// internal/app/accounts.go
+
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"cookieBot/internal/applock"
+	"cookieBot/internal/db"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"go.uber.org/zap"
+)
+
+// AccountService 는 Gmail 계정 저장소에 대한 UI 동작을 제공합니다.
+type AccountService struct {
+	uiCtx  context.Context
+	logger *zap.Logger
+	store  *db.EmailDB
+	lock   *applock.Lock
+
+	// imports 는 PreviewAccountImport 가 발급한 토큰과 대화상자에서 고른 파일 경로입니다.
+	importsMu sync.Mutex
+	imports   map[string]string
+}
+
+// MaskedPassword 는 목록 등에서 비밀번호 대신 반환되는 값입니다.
+// UpdateAccount 에 이 값을 그대로 넘기면 기존 비밀번호가 유지됩니다.
+const MaskedPassword = db.MaskedPassword
+
+// ListAccounts 는 저장된 모든 계정을 반환합니다. 비밀번호는 가려지며, 행마다 RevealPassword 로 확인합니다.
+func (s *AccountService) ListAccounts() ([]db.GmailAccount, error) {
+	accounts, err := s.store.ListEmails()
+	if err != nil {
+		return nil, err
+	}
+	for i := range accounts {
+		maskAccount(&accounts[i])
+	}
+	return accounts, nil
+}
+
+// GetAccount 는 이메일 주소로 비밀번호를 포함한 계정을 조회합니다. 앱이 잠겨 있으면 거부합니다.
+func (s *AccountService) GetAccount(email string) (*db.GmailAccount, error) {
+	if err := s.lock.Require(); err != nil {
+		return nil, err
+	}
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	return s.store.GetEmail(email)
+}
+
+// RevealPassword 는 계정 하나의 비밀번호를 반환합니다. 앱이 잠겨 있으면 거부합니다.
+func (s *AccountService) RevealPassword(email string) (string, error) {
+	account, err := s.GetAccount(email)
+	if err != nil {
+		return "", err
+	}
+	s.logger.Info("Account password revealed", zap.String("email", email))
+	return account.Password, nil
+}
+
+// CreateAccount 는 새 계정을 저장합니다. 같은 이메일이 이미 있으면 충돌 오류를 반환합니다.
+func (s *AccountService) CreateAccount(account db.GmailAccount) (*db.GmailAccount, error) {
+	if err := s.lock.Require(); err != nil {
+		return nil, err
+	}
+	if err := validateAccount(account); err != nil {
+		return nil, err
+	}
+	created, err := s.store.CreateEmail(account)
+	maskAccount(created)
+	return created, err
+}
+
+// UpdateAccount 는 계정을 수정합니다. account.Version 은 읽어 온 값을 그대로 넘겨야 하며,
+// 그 사이 다른 사용자가 수정했다면 충돌 오류를 반환합니다. 이 경우 다시 불러온 뒤 재시도합니다.
+// 비밀번호가 MaskedPassword 이면 기존 비밀번호를 유지합니다.
+func (s *AccountService) UpdateAccount(account db.GmailAccount) (*db.GmailAccount, error) {
+	if err := s.lock.Require(); err != nil {
+		return nil, err
+	}
+	return s.updateAccount(account)
+}
+
+func (s *AccountService) updateAccount(account db.GmailAccount) (*db.GmailAccount, error) {
+	if account.Password == MaskedPassword {
+		current, err := s.store.GetEmail(account.Email)
+		if err != nil {
+			return nil, err
+		}
+		account.Password = current.Password
+	}
+	if err := validateAccount(account); err != nil {
+		return nil, err
+	}
+	updated, err := s.store.UpdateEmail(account)
+	if errors.Is(err, db.ErrConflict) {
+		s.logger.Warn("Account update conflict", zap.String("email", account.Email), zap.Error(err))
+	}
+	maskAccount(updated)
+	return updated, err
+}
+
+// SetAccountUsed 는 계정의 사용 여부만 변경합니다. version 은 화면에 표시된 계정의 버전입니다.
+// 비밀번호를 다루지 않으므로 잠긴 상태에서도 사용할 수 있습니다.
+func (s *AccountService) SetAccountUsed(email string, used bool, version int64) (*db.GmailAccount, error) {
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	account, err := s.store.GetEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	account.Used = used
+	account.Version = version
+	return s.updateAccount(*account)
+}
+
+// DeleteAccount 는 계정을 삭제합니다. 앱이 잠겨 있으면 거부합니다.
+func (s *AccountService) DeleteAccount(email string) error {
+	if err := s.lock.Require(); err != nil {
+		return err
+	}
+	if err := validateEmail(email); err != nil {
+		return err
+	}
+	return s.store.DeleteEmail(email)
+}
+
+// AccountImport 는 가져오기 미리보기와 그 파일을 가리키는 토큰입니다. 확정할 때 Token 을 ImportAccounts 에 넘깁니다.
+// 파일 경로는 UI 로 내보내지 않으므로 ImportAccounts 는 대화상자에서 고른 파일만 읽을 수 있습니다.
+type AccountImport struct {
+	Token   string            `json:"token"`
+	Preview *db.ImportPreview `json:"preview"`
+}
+
+var accountFileFilters = []runtime.FileFilter{{DisplayName: "Accounts (*.csv;*.tsv;*.json)", Pattern: "*.csv;*.tsv;*.json"}}
+
+// PreviewAccountImport 는 파일 선택 창으로 CSV/JSON 파일을 고르고, 저장하지 않고 행별 검증 결과를 반환합니다.
+// 사용자가 선택을 취소하면 nil 을 반환합니다.
+func (s *AccountService) PreviewAccountImport(opts db.ImportOptions) (*AccountImport, error) {
+	path, err := runtime.OpenFileDialog(s.uiCtx, runtime.OpenDialogOptions{
+		Title:   "계정 가져오기",
+		Filters: accountFileFilters,
+	})
+	if err != nil || path == "" {
+		return nil, err
+	}
+	preview, err := s.importAccounts(path, opts, true)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.addImport(path)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountImport{Token: token, Preview: preview}, nil
+}
+
+// ImportAccounts 는 token 으로 미리보기한 파일을 다시 읽어 검증하고, 문제가 없는 행만 저장합니다.
+// 저장을 마친 토큰은 폐기되며, 파일을 읽지 못하는 등 저장 전에 실패하면 같은 토큰으로 다시 시도할 수 있습니다.
+func (s *AccountService) ImportAccounts(token string, opts db.ImportOptions) (*AccountImport, error) {
+	if err := s.lock.Require(); err != nil {
+		return nil, err
+	}
+	s.importsMu.Lock()
+	path, ok := s.imports[token]
+	s.importsMu.Unlock()
+	if !ok {
+		return nil, invalidArgument("unknown or expired import token; preview the file again")
+	}
+	preview, err := s.importAccounts(path, opts, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.importsMu.Lock()
+	delete(s.imports, token)
+	s.importsMu.Unlock()
+	return &AccountImport{Preview: preview}, nil
+}
+
+// addImport 는 path 에 대한 새 토큰을 발급합니다.
+func (s *AccountService) addImport(path string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to create import token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	s.importsMu.Lock()
+	defer s.importsMu.Unlock()
+	if s.imports == nil {
+		s.imports = make(map[string]string)
+	}
+	s.imports[token] = path
+	return token, nil
+}
+
+func (s *AccountService) importAccounts(path string, opts db.ImportOptions, dryRun bool) (*db.ImportPreview, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if opts.Format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv", ".txt":
+			opts.Format = db.ImportFormatCSV
+		case ".tsv":
+			opts.Format = db.ImportFormatCSV
+			if opts.Delimiter == "" {
+				opts.Delimiter = "\t"
+			}
+		case ".json":
+			opts.Format = db.ImportFormatJSON
+		}
+	}
+
+	preview, err := s.store.ImportAccounts(data, opts, dryRun)
+	if err != nil {
+		s.logger.Error("Failed to import accounts", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	s.logger.Info("Accounts import processed",
+		zap.String("path", path), zap.Bool("dryRun", dryRun),
+		zap.Int("valid", preview.Valid), zap.Int("invalid", preview.Invalid), zap.Int("imported", preview.Imported))
+	for i := range preview.Rows {
+		maskAccount(&preview.Rows[i].Account)
+	}
+	return preview, nil
+}
+
+// maskAccount 는 UI 로 돌려보내는 계정의 비밀번호를 가립니다.
+func maskAccount(account *db.GmailAccount) {
+	if account != nil {
+		account.MaskPassword()
+	}
+}
+
+func validateAccount(account db.GmailAccount) error {
+	if issues := db.ValidateAccount(account); len(issues) > 0 {
+		return invalidArgument("%s", strings.Join(issues, "; "))
+	}
+	return nil
+}
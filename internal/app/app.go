@@ -0,0 +1,344 @@
+// internal/app/app.go
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	antidetect "cookieBot/internal/anti"
+	"cookieBot/internal/applock"
+	"cookieBot/internal/audit"
+	"cookieBot/internal/browser"
+	"cookieBot/internal/db"
+	"cookieBot/internal/health"
+	"cookieBot/internal/secrets"
+	"cookieBot/internal/vm"
+	"cookieBot/utils"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"go.uber.org/zap"
+)
+
+// 종료 시 실행 중인 프로필을 정리하는 데 허용하는 최대 시간
+const stopProfilesTimeout = 10 * time.Second
+
+// App 은 프론트엔드에 노출되는 서비스들과 그 수명 주기를 관리합니다.
+// internal 패키지들은 일반 라이브러리로 남고, UI 에는 App 이 만든 서비스만 바인딩됩니다.
+type App struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	logger *zap.Logger
+	// Wails 런타임 컨텍스트 (Startup 에서 설정, 이벤트/창 제어에 사용)
+	uiCtx context.Context
+
+	// 종료 시 삭제할 임시 경로
+	cleanupPaths []string
+	// 종료 시 이번 세션에서 실행한 프로필을 종료할지 여부
+	stopProfilesOnShutdown bool
+	// 계정 저장소 확인은 처음 잠금이 풀렸을 때 한 번만 실행합니다.
+	storeCheck sync.Once
+
+	Profiles   *ProfileService
+	Templates  *TemplateService
+	Accounts   *AccountService
+	VM         *VMService
+	AntiDetect *AntiDetectService
+	Audit      *AuditService
+	Lock       *LockService
+	Health     *HealthService
+	Diag       *DiagnosticsService
+	Errors     *ErrorService
+}
+
+// New 는 설정 파일 경로로 계정 저장소를 열고 서비스들을 구성합니다.
+func New(logger *zap.Logger, configPath string) (*App, error) {
+	dataDir, err := utils.AppDataDir()
+	if err != nil {
+		return nil, err
+	}
+
+	// 변경 작업 감사 로그. 열 수 없어도 앱은 계속 동작하며 기록만 생략됩니다.
+	auditLog, err := audit.Open(filepath.Join(dataDir, "audit.jsonl"))
+	if err != nil {
+		logger.Error("Failed to open audit log", zap.Error(err))
+	}
+
+	// 마스터 패스프레이즈 잠금. 설정 파일이 손상되었으면 비밀 정보를 열 수 없으므로 시작하지 않습니다.
+	lock, err := applock.Open(filepath.Join(dataDir, "applock.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	// 설정의 "secret://" 참조를 푸는 저장소. OS 키링이 없으면 마스터 패스프레이즈 키로 암호화한 파일을 씁니다.
+	secretStore := secrets.Open(filepath.Join(dataDir, "secrets.enc"), lock.Key)
+	if fs, ok := secretStore.(*secrets.FileStore); ok {
+		lock.OnRekey(fs.Rekey)
+	}
+
+	emailDB, err := db.NewEmailDB(configPath, secretStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize EmailDB: %w", err)
+	}
+
+	// 앱 수명 동안 유지되는 컨텍스트. Shutdown 에서 취소되어 진행 중인 다운로드 등을 중단시킵니다.
+	ctx, cancel := context.WithCancel(context.Background())
+	bm := browser.NewBrowserManager(ctx, logger)
+	providerPath := filepath.Join(dataDir, "provider.json")
+	if name, err := browser.LoadProviderSetting(providerPath); err != nil {
+		logger.Error("Failed to load provider setting", zap.Error(err))
+	} else if name != "" {
+		if err := bm.UseProvider(name); err != nil {
+			logger.Error("Failed to select saved provider", zap.String("provider", name), zap.Error(err))
+		}
+	}
+	vmd := vm.VMDownload(ctx, logger)
+	add := antidetect.AntiDetectDownload(ctx, logger)
+
+	vmm := vm.VMMain(logger)
+
+	bm.SetAuditLog(auditLog)
+	emailDB.SetAuditLog(auditLog)
+	vmd.SetAuditLog(auditLog)
+	add.SetAuditLog(auditLog)
+
+	healthRegistry := newHealthRegistry(vmm, add, bm, emailDB, dataDir)
+
+	return &App{
+		ctx:        ctx,
+		cancel:     cancel,
+		logger:     logger,
+		Profiles:   newProfileService(ctx, logger, bm, providerPath),
+		Templates:  &TemplateService{logger: logger, store: browser.NewTemplateStore(filepath.Join(dataDir, "templates.json")), browser: bm},
+		Accounts:   &AccountService{logger: logger, store: emailDB, lock: lock},
+		VM:         &VMService{logger: logger, vm: vmm, download: vmd},
+		AntiDetect: &AntiDetectService{logger: logger, add: add},
+		Audit:      &AuditService{logger: logger, log: auditLog},
+		Lock:       &LockService{logger: logger, lock: lock},
+		Health:     &HealthService{logger: logger, registry: healthRegistry},
+		Diag:       &DiagnosticsService{logger: logger, configPath: configPath, audit: auditLog, health: healthRegistry, browser: bm, secrets: secretStore, vm: vmm, add: add},
+		Errors:     newErrorService(logger, bm),
+		// 확인 창에서 답을 받지 못하면 실행한 프로필은 정리합니다.
+		stopProfilesOnShutdown: true,
+	}, nil
+}
+
+// Bindings 는 Wails 에 바인딩할 서비스 목록을 반환합니다.
+func (a *App) Bindings() []interface{} {
+	return []interface{}{
+		a.Profiles,
+		a.Templates,
+		a.Accounts,
+		a.VM,
+		a.AntiDetect,
+		a.Audit,
+		a.Lock,
+		a.Health,
+		a.Diag,
+		a.Errors,
+	}
+}
+
+// AddCleanupPath 는 종료 시 삭제할 임시 파일/디렉토리를 등록합니다.
+func (a *App) AddCleanupPath(path string) {
+	a.cleanupPaths = append(a.cleanupPaths, path)
+}
+
+// Startup 은 Wails OnStartup 훅입니다.
+func (a *App) Startup(ctx context.Context) {
+	a.uiCtx = ctx
+	a.Profiles.uiCtx = ctx
+	a.Accounts.uiCtx = ctx
+	a.Audit.uiCtx = ctx
+	a.Diag.uiCtx = ctx
+
+	// 브라우저 API 연결 상태(재연결 중 등)를 사이드바에 전달
+	a.Profiles.browser.OnAPIStateChange(func(state browser.BreakerState) {
+		runtime.EventsEmit(ctx, browser.APIStateChanged, state)
+	})
+
+	// 프로필 상태 변화를 프론트엔드 이벤트로 전달 (앱 컨텍스트가 취소되면 종료)
+	go a.Profiles.browser.WatchProfiles(a.ctx, browser.WatchOptions{
+		Interval: browser.DefaultWatchInterval,
+		OnEvent: func(ev browser.ProfileEvent) {
+			runtime.EventsEmit(ctx, ev.Type, ev)
+		},
+	})
+
+	// 잠금 상태를 UI 에 알리고, 유휴 시간이 지나면 자동으로 잠급니다.
+	// AWS 키가 암호화 파일에 있을 수 있으므로 계정 저장소 확인은 처음 잠금이 풀린 뒤에 합니다.
+	a.Lock.lock.OnChange(func(status applock.Status) {
+		runtime.EventsEmit(ctx, LockStateChanged, status)
+		if !status.Locked {
+			a.storeCheck.Do(func() { go a.checkAccountStore() })
+		}
+	})
+	go a.Lock.lock.Run(a.ctx)
+
+	// 구성 요소 상태를 주기적으로 검사하고, 바뀐 결과만 사이드바에 알립니다.
+	a.Health.registry.OnChange(func(report health.Report) {
+		runtime.EventsEmit(ctx, HealthChanged, report)
+	})
+	go a.Health.registry.Watch(a.ctx, healthInterval)
+
+	a.logger.Info("Application started")
+}
+
+// checkAccountStore 는 계정 테이블 구성을 확인합니다. 테이블이 없으면 만들지 묻고,
+// 키 구성이 잘못되었으면 설정을 고치도록 안내합니다.
+func (a *App) checkAccountStore() {
+	store := a.Accounts.store
+	err := store.CheckSchema(a.ctx)
+	if err == nil {
+		a.migrateAccountStore()
+		return
+	}
+	a.logger.Error("Account store check failed", zap.String("table", store.TableName()), zap.Error(err))
+
+	var schemaErr *db.SchemaError
+	if !errors.As(err, &schemaErr) || !schemaErr.Missing {
+		runtime.MessageDialog(a.uiCtx, runtime.MessageDialogOptions{
+			Type:    runtime.ErrorDialog,
+			Title:   "계정 저장소 오류",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	answer, dialogErr := runtime.MessageDialog(a.uiCtx, runtime.MessageDialogOptions{
+		Type:          runtime.QuestionDialog,
+		Title:         "계정 테이블 없음",
+		Message:       fmt.Sprintf("계정 테이블 %q 이(가) 없습니다. 설정의 TableName/Region 을 확인하세요.\n지금 테이블을 만들까요?", store.TableName()),
+		Buttons:       []string{"Yes", "No"},
+		DefaultButton: "No",
+	})
+	if dialogErr != nil || !strings.EqualFold(answer, "Yes") {
+		return
+	}
+
+	if err := store.CreateTable(a.ctx); err != nil {
+		a.logger.Error("Failed to create accounts table", zap.Error(err))
+		runtime.MessageDialog(a.uiCtx, runtime.MessageDialogOptions{
+			Type:    runtime.ErrorDialog,
+			Title:   "계정 테이블 생성 실패",
+			Message: err.Error(),
+		})
+		return
+	}
+	a.logger.Info("Accounts table created", zap.String("table", store.TableName()))
+	a.migrateAccountStore()
+}
+
+// migrateAccountStore 는 계정 저장소에 아직 적용되지 않은 마이그레이션을 실행하고 진행 상황을 UI 에 알립니다.
+// 중단되면 다음 실행 때 이어서 진행됩니다.
+func (a *App) migrateAccountStore() {
+	report, err := a.Accounts.store.Migrate(a.ctx, db.MigrationOptions{
+		OnProgress: func(p db.MigrationProgress) {
+			runtime.EventsEmit(a.uiCtx, "accounts:migration", p)
+		},
+	})
+	if err != nil {
+		a.logger.Error("Account store migration failed", zap.Error(err))
+		return
+	}
+	if report.From != report.To {
+		a.logger.Info("Account store migrated", zap.Int("from", report.From), zap.Int("to", report.To))
+	}
+}
+
+// HandleArgs 는 명령행 인자를 처리합니다. 현재는 "--profile <id>" 로 프로필을 열 수 있습니다.
+func (a *App) HandleArgs(args []string) {
+	profileID := profileArg(args)
+	if profileID == "" {
+		return
+	}
+
+	go func() {
+		if _, err := a.Profiles.StartProfile(profileID); err != nil {
+			a.logger.Error("Failed to open profile from arguments", zap.String("profileID", profileID), zap.Error(err))
+			return
+		}
+		runtime.EventsEmit(a.uiCtx, "profile:open", profileID)
+	}()
+}
+
+// SecondInstanceLaunched 는 두 번째 실행이 전달한 인자를 받아 창을 앞으로 가져오고 인자를 처리합니다.
+func (a *App) SecondInstanceLaunched(args []string) {
+	a.logger.Info("Second instance launched", zap.Strings("args", args))
+	runtime.WindowUnminimise(a.uiCtx)
+	runtime.WindowShow(a.uiCtx)
+	a.HandleArgs(args)
+}
+
+func profileArg(args []string) string {
+	for i, arg := range args {
+		switch {
+		case arg == "--profile" && i+1 < len(args):
+			return args[i+1]
+		case strings.HasPrefix(arg, "--profile="):
+			return strings.TrimPrefix(arg, "--profile=")
+		}
+	}
+	return ""
+}
+
+// BeforeClose 는 Wails OnBeforeClose 훅입니다. 이번 세션에서 실행한 프로필이 있으면
+// 함께 종료할지 묻습니다. 창 닫기는 막지 않습니다.
+func (a *App) BeforeClose(ctx context.Context) (prevent bool) {
+	launched := a.Profiles.browser.LaunchedProfiles()
+	if len(launched) == 0 {
+		return false
+	}
+
+	answer, err := runtime.MessageDialog(ctx, runtime.MessageDialogOptions{
+		Type:          runtime.QuestionDialog,
+		Title:         "실행 중인 프로필",
+		Message:       fmt.Sprintf("이번 세션에서 실행한 프로필 %d개가 아직 실행 중입니다. 함께 종료할까요?", len(launched)),
+		Buttons:       []string{"Yes", "No"},
+		DefaultButton: "Yes",
+	})
+	if err != nil {
+		a.logger.Warn("Failed to show close confirmation dialog", zap.Error(err))
+		return false
+	}
+
+	a.stopProfilesOnShutdown = strings.EqualFold(answer, "Yes")
+	a.logger.Info("Close confirmed", zap.Bool("stopProfiles", a.stopProfilesOnShutdown))
+	return false
+}
+
+// Shutdown 은 Wails OnShutdown 훅입니다. 실행한 프로필 종료, 진행 중인 작업 취소,
+// 임시 파일 삭제, 로그 flush 를 순서대로 수행합니다.
+func (a *App) Shutdown(ctx context.Context) {
+	a.logger.Info("Application shutting down")
+
+	if a.stopProfilesOnShutdown {
+		stopCtx, cancel := context.WithTimeout(context.Background(), stopProfilesTimeout)
+		for id, err := range a.Profiles.browser.StopLaunchedProfiles(stopCtx) {
+			a.logger.Warn("Failed to stop profile on shutdown", zap.String("profileID", id), zap.Error(err))
+		}
+		cancel()
+	}
+
+	// 진행 중인 다운로드 등 앱 컨텍스트를 사용하는 작업 취소
+	a.cancel()
+
+	a.VM.download.Cleanup()
+	a.AntiDetect.add.Cleanup()
+	for _, path := range a.cleanupPaths {
+		if err := os.RemoveAll(path); err != nil {
+			a.logger.Warn("Failed to remove temp path", zap.String("path", path), zap.Error(err))
+		}
+	}
+
+	a.Audit.log.Close()
+
+	a.logger.Info("Application shutdown completed")
+	a.logger.Sync()
+}
@@ -0,0 +1,77 @@
+// internal/app/audit.go
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"cookieBot/internal/apperr"
+	"cookieBot/internal/audit"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"go.uber.org/zap"
+)
+
+// 한 번에 조회할 수 있는 최대 감사 로그 항목 수
+const maxAuditEntries = 1000
+
+// AuditService 는 감사 로그 조회/내보내기/검증을 제공합니다.
+type AuditService struct {
+	uiCtx  context.Context
+	logger *zap.Logger
+	log    *audit.Log
+}
+
+// QueryAudit 은 조건에 맞는 감사 로그 항목을 시간 순으로 반환합니다. Limit 이 없으면 최근 항목 maxAuditEntries 개입니다.
+func (s *AuditService) QueryAudit(q audit.Query) ([]audit.Entry, error) {
+	if s.log == nil {
+		return nil, apperr.New(apperr.AuditUnavailable)
+	}
+	if q.Limit <= 0 || q.Limit > maxAuditEntries {
+		q.Limit = maxAuditEntries
+	}
+	return s.log.Query(q)
+}
+
+// VerifyAudit 은 해시 사슬을 검사해 검증된 항목 수를 반환합니다. 변조가 있으면 첫 위치를 오류로 알려 줍니다.
+func (s *AuditService) VerifyAudit() (int, error) {
+	if s.log == nil {
+		return 0, apperr.New(apperr.AuditUnavailable)
+	}
+	count, err := s.log.Verify()
+	if err != nil {
+		s.logger.Warn("Audit log verification failed", zap.Error(err))
+	}
+	return count, err
+}
+
+// ExportAudit 은 저장 창으로 고른 파일에 조건에 맞는 항목을 JSONL 로 내보냅니다.
+// 사용자가 취소하면 빈 경로를 반환합니다.
+func (s *AuditService) ExportAudit(q audit.Query) (string, error) {
+	if s.log == nil {
+		return "", apperr.New(apperr.AuditUnavailable)
+	}
+	path, err := runtime.SaveFileDialog(s.uiCtx, runtime.SaveDialogOptions{
+		Title:           "감사 로그 내보내기",
+		DefaultFilename: "cookiebot-audit.jsonl",
+		Filters:         []runtime.FileFilter{{DisplayName: "Audit log (*.jsonl)", Pattern: "*.jsonl"}},
+	})
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	count, err := s.log.Export(file, q)
+	if err != nil {
+		return "", err
+	}
+	s.logger.Info("Audit log exported", zap.String("path", path), zap.Int("entries", count))
+	return path, nil
+}
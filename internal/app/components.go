@@ -0,0 +1,63 @@
+// internal/app/components.go
+
+package app
+
+import (
+	antidetect "cookieBot/internal/anti"
+	"cookieBot/internal/vm"
+
+	"go.uber.org/zap"
+)
+
+// VMService 는 VMware 설치 상태 확인과 설치를 제공합니다.
+type VMService struct {
+	logger   *zap.Logger
+	vm       *vm.VM
+	download *vm.VMD
+}
+
+// Status 는 VMware 설치 상태를 반환합니다.
+func (s *VMService) Status() vm.VMWareStatus {
+	return s.vm.CheckVMWareStatus()
+}
+
+// ListVirtualMachines 는 VM 폴더의 가상 머신 목록을 반환합니다.
+func (s *VMService) ListVirtualMachines() ([]vm.VirtualMachine, error) {
+	return s.vm.ListVirtualMachines()
+}
+
+// Install 은 VMware 를 다운로드하고 설치 프로그램을 실행합니다.
+func (s *VMService) Install() error {
+	return s.download.DownloadAndInstallVMWare()
+}
+
+// InstallProgress 는 VMware 다운로드 진행률(0-100)을 반환합니다.
+func (s *VMService) InstallProgress() int {
+	return s.download.GetInstallationProgress()
+}
+
+// AntiDetectService 는 Undetectable 설치/실행 관리를 제공합니다.
+type AntiDetectService struct {
+	logger *zap.Logger
+	add    *antidetect.ADD
+}
+
+// IsInstalled 는 Undetectable 설치 여부를 반환합니다.
+func (s *AntiDetectService) IsInstalled() (bool, error) {
+	return s.add.IsAntiDetectInstalled()
+}
+
+// IsRunning 은 Undetectable 실행 여부를 반환합니다.
+func (s *AntiDetectService) IsRunning() (bool, error) {
+	return s.add.IsAntiDetectRunning()
+}
+
+// Run 은 Undetectable 을 실행하며, 설치되어 있지 않으면 설치를 시작합니다.
+func (s *AntiDetectService) Run() error {
+	return s.add.RunAntiDetect()
+}
+
+// Install 은 Undetectable 을 다운로드하고 설치 프로그램을 실행합니다.
+func (s *AntiDetectService) Install() error {
+	return s.add.DownloadAndInstallAntiDetect()
+}
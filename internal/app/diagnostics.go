@@ -0,0 +1,85 @@
+// internal/app/diagnostics.go
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	antidetect "cookieBot/internal/anti"
+	"cookieBot/internal/audit"
+	"cookieBot/internal/browser"
+	"cookieBot/internal/diag"
+	"cookieBot/internal/health"
+	"cookieBot/internal/secrets"
+	"cookieBot/internal/vm"
+	"cookieBot/utils"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"go.uber.org/zap"
+)
+
+// DiagnosticsService 는 문제 보고용 진단 번들을 만듭니다.
+type DiagnosticsService struct {
+	uiCtx      context.Context
+	logger     *zap.Logger
+	configPath string
+	audit      *audit.Log
+	health     *health.Registry
+	browser    *browser.BrowserManager
+	secrets    secrets.Store
+	vm         *vm.VM
+	add        *antidetect.ADD
+}
+
+// CreateBundle 은 저장 창으로 고른 경로에 로그, 비밀 값을 가린 설정, 버전, 상태 검사 결과, OS 정보,
+// 최근 감사 로그를 zip 으로 묶어 저장합니다. 사용자가 취소하면 빈 경로를 반환합니다.
+func (s *DiagnosticsService) CreateBundle() (string, error) {
+	path, err := runtime.SaveFileDialog(s.uiCtx, runtime.SaveDialogOptions{
+		Title:           "진단 번들 만들기",
+		DefaultFilename: fmt.Sprintf("cookiebot-diagnostics-%s.zip", time.Now().Format("20060102-150405")),
+		Filters:         []runtime.FileFilter{{DisplayName: "Diagnostics bundle (*.zip)", Pattern: "*.zip"}},
+	})
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	opts := diag.Options{
+		ConfigPath: s.configPath,
+		Secrets:    s.secrets,
+		Versions:   s.componentVersions(),
+		Health:     s.health.Run(context.Background()),
+		Audit:      s.audit,
+	}
+	if logPath, err := utils.LogFilePath(); err == nil {
+		opts.LogPath = logPath
+	}
+	// 로그 버퍼를 비워 번들에 최신 로그가 들어가도록 합니다.
+	s.logger.Sync()
+
+	manifest, err := diag.WriteFile(path, opts)
+	if err != nil {
+		s.logger.Error("Failed to create diagnostics bundle", zap.String("path", path), zap.Error(err))
+		return "", err
+	}
+	s.logger.Info("Diagnostics bundle created", zap.String("path", path), zap.Strings("files", manifest.Files))
+	return path, nil
+}
+
+// componentVersions 는 브라우저 공급자와 설치된 VMware, Undetectable 의 버전입니다.
+// 버전을 읽지 못하면(설치되지 않음 등) 그 이유를 값으로 남깁니다.
+func (s *DiagnosticsService) componentVersions() map[string]string {
+	versions := map[string]string{"browser_provider": s.browser.Provider().Name()}
+	for name, installed := range map[string]func() (string, error){
+		"vmware":       s.vm.InstalledVersion,
+		"undetectable": s.add.InstalledVersion,
+	} {
+		version, err := installed()
+		if err != nil {
+			version = "unknown: " + err.Error()
+		}
+		versions[name] = version
+	}
+	return versions
+}
@@ -0,0 +1,154 @@
+// internal/app/errors.go
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+
+	"cookieBot/internal/apperr"
+	"cookieBot/internal/applock"
+	"cookieBot/internal/browser"
+	"cookieBot/internal/cdp"
+	"cookieBot/internal/db"
+	"cookieBot/internal/secrets"
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+// 오류 메시지의 {provider} 에 넣을 공급자 표시 이름
+var providerLabels = map[string]string{
+	browser.ProviderUndetectable: "Undetectable",
+	browser.ProviderHideMyAcc:    "HideMyAcc",
+}
+
+// 내부 패키지의 오류를 코드로 옮기는 표. 앞에서부터 처음 일치하는 항목을 씁니다.
+var errorCodes = []struct {
+	target error
+	code   apperr.Code
+}{
+	{applock.ErrLocked, apperr.AppLocked},
+	{applock.ErrWrongPassphrase, apperr.WrongPassphrase},
+	{applock.ErrNotConfigured, apperr.LockNotConfigured},
+	{applock.ErrPassphraseTooShort, apperr.InvalidArgument},
+	{browser.ErrAPIUnavailable, apperr.BrowserAPIUnavailable},
+	{browser.ErrNotSupported, apperr.ProviderUnsupported},
+	{browser.ErrProfileNotFound, apperr.ProfileNotFound},
+	{browser.ErrTemplateNotFound, apperr.TemplateNotFound},
+	{browser.ErrInvalidProfileOption, apperr.InvalidArgument},
+	{cdp.ErrNotRunning, apperr.ProfileNotRunning},
+	{db.ErrAccountNotFound, apperr.AccountNotFound},
+	{db.ErrConflict, apperr.StoreConflict},
+	{secrets.ErrNotFound, apperr.SecretNotFound},
+	{utils.ErrDecrypt, apperr.DecryptFailed},
+	{utils.ErrChecksumMismatch, apperr.DownloadChecksumMismatch},
+	{context.Canceled, apperr.Cancelled},
+}
+
+// invalidArgument 는 바인딩된 메서드의 입력 검증 오류를 만듭니다. 원인에는 영어 설명이 남습니다.
+func invalidArgument(format string, args ...interface{}) error {
+	return apperr.Wrap(apperr.InvalidArgument, fmt.Errorf(format, args...))
+}
+
+// ErrorService 는 오류 메시지 언어와 메시지 카탈로그를 제공합니다.
+// 바인딩된 메서드가 반환한 오류는 Wails 의 ErrorFormatter 에서 FormatError 로 apperr.Payload 가 됩니다.
+type ErrorService struct {
+	logger  *zap.Logger
+	browser *browser.BrowserManager
+
+	mu   sync.RWMutex
+	lang string
+}
+
+func newErrorService(logger *zap.Logger, bm *browser.BrowserManager) *ErrorService {
+	return &ErrorService{logger: logger, browser: bm, lang: apperr.DefaultLanguage}
+}
+
+// Languages 는 선택할 수 있는 메시지 언어 목록입니다.
+func (s *ErrorService) Languages() []string {
+	return apperr.Languages()
+}
+
+// Language 는 오류 메시지에 쓰는 현재 언어입니다.
+func (s *ErrorService) Language() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lang
+}
+
+// SetLanguage 는 오류 메시지 언어를 바꿉니다.
+func (s *ErrorService) SetLanguage(lang string) error {
+	for _, l := range apperr.Languages() {
+		if l == lang {
+			s.mu.Lock()
+			s.lang = lang
+			s.mu.Unlock()
+			return nil
+		}
+	}
+	return invalidArgument("unsupported language %q", lang)
+}
+
+// Catalog 는 lang 의 메시지 카탈로그(키 → 메시지)를 반환합니다. 프론트엔드가 직접 메시지를 만들 때 씁니다.
+func (s *ErrorService) Catalog(lang string) map[string]string {
+	return apperr.Catalog(lang)
+}
+
+// format 은 err 를 코드로 분류해 현재 언어의 Payload 로 만듭니다.
+func (s *ErrorService) format(err error) apperr.Payload {
+	e := s.classify(err)
+	if e.Code == apperr.Internal {
+		s.logger.Warn("Unclassified error returned to frontend", zap.Error(err))
+	}
+	return e.Localize(s.Language())
+}
+
+// classify 는 err 사슬에 *apperr.Error 가 있으면 그대로, 없으면 알려진 오류를 찾아 코드를 붙입니다.
+func (s *ErrorService) classify(err error) *apperr.Error {
+	var e *apperr.Error
+	if !errors.As(err, &e) {
+		e = apperr.Wrap(s.codeOf(err), err)
+	}
+	switch e.Code {
+	case apperr.BrowserAPIUnavailable, apperr.ProviderUnsupported:
+		if _, ok := e.Params["provider"]; !ok {
+			e.With("provider", s.providerLabel())
+		}
+	}
+	return e
+}
+
+func (s *ErrorService) codeOf(err error) apperr.Code {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.target) {
+			return c.code
+		}
+	}
+	var schemaErr *db.SchemaError
+	if errors.As(err, &schemaErr) {
+		return apperr.StoreSchemaInvalid
+	}
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return apperr.FileAccess
+	}
+	return apperr.Internal
+}
+
+func (s *ErrorService) providerLabel() string {
+	name := s.browser.Provider().Name()
+	if label, ok := providerLabels[name]; ok {
+		return label
+	}
+	return name
+}
+
+// FormatError 는 Wails ErrorFormatter 입니다. 바인딩된 모든 메서드의 오류가
+// { code, key, params, message, cause } 형태로 프론트엔드에 전달됩니다.
+func (a *App) FormatError(err error) any {
+	return a.Errors.format(err)
+}
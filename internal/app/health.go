@@ -0,0 +1,120 @@
+// internal/app/health.go
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	antidetect "cookieBot/internal/anti"
+	"cookieBot/internal/applock"
+	"cookieBot/internal/browser"
+	"cookieBot/internal/db"
+	"cookieBot/internal/health"
+	"cookieBot/internal/vm"
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// HealthChanged 는 상태 검사 결과가 바뀌었을 때 보내는 이벤트입니다.
+	HealthChanged = "health:changed"
+	// 주기적 상태 검사 간격
+	healthInterval = 30 * time.Second
+
+	// 남은 디스크 공간 경고/실패 기준
+	diskWarnBelow = 1 << 30
+	diskFailBelow = 200 << 20
+)
+
+// HealthService 는 사이드바에 보여 줄 시스템 상태 검사를 제공합니다.
+type HealthService struct {
+	logger   *zap.Logger
+	registry *health.Registry
+}
+
+// Check 는 모든 검사를 지금 실행해 결과를 반환합니다.
+func (s *HealthService) Check() health.Report {
+	return s.registry.Run(context.Background())
+}
+
+// Last 는 마지막 주기 검사 결과를 반환합니다. 아직 검사하지 않았으면 지금 실행합니다.
+func (s *HealthService) Last() health.Report {
+	if report := s.registry.Last(); !report.CheckedAt.IsZero() {
+		return report
+	}
+	return s.Check()
+}
+
+// newHealthRegistry 는 앱 구성 요소의 상태 검사를 등록합니다.
+func newHealthRegistry(v *vm.VM, add *antidetect.ADD, bm *browser.BrowserManager, store *db.EmailDB, dataDir string) *health.Registry {
+	r := health.NewRegistry()
+
+	r.Register(health.Check{Name: "vmware", Run: func(ctx context.Context) health.Outcome {
+		st := v.CheckVMWareStatus()
+		switch {
+		case !st.VmwareExists || !st.VmrunExists:
+			return health.Fail("VMware Workstation is not installed", "사이드바에서 VMware 를 설치하세요.")
+		case !st.VmFolderExists:
+			return health.Warn("virtual machine folder not found", "문서\\Virtual Machines 폴더에 가상 머신을 만드세요.")
+		}
+		return health.OK("VMware Workstation is installed")
+	}})
+
+	r.Register(health.Check{Name: "antidetect", Run: func(ctx context.Context) health.Outcome {
+		if name := bm.Provider().Name(); name != browser.ProviderUndetectable {
+			return health.OK(fmt.Sprintf("using %s; Undetectable is not required", name))
+		}
+		installed, err := add.IsAntiDetectInstalled()
+		if err != nil {
+			return health.Warn(fmt.Sprintf("could not check Undetectable install: %v", err), "")
+		}
+		if !installed {
+			return health.Fail("Undetectable is not installed", "사이드바에서 Undetectable 을 설치하세요.")
+		}
+		running, err := add.IsAntiDetectRunning()
+		if err != nil {
+			return health.Warn(fmt.Sprintf("could not check Undetectable process: %v", err), "")
+		}
+		if !running {
+			return health.Warn("Undetectable is installed but not running", "사이드바에서 실행 버튼을 누르세요.")
+		}
+		return health.OK("Undetectable is running")
+	}})
+
+	r.Register(health.Check{Name: "browser_api", Run: func(ctx context.Context) health.Outcome {
+		name := bm.Provider().Name()
+		if err := bm.Ping(ctx); err != nil {
+			return health.Fail(fmt.Sprintf("%s API is not reachable: %v", name, err), "안티디텍트 브라우저가 실행 중인지 확인하세요. 실행 후 자동으로 다시 연결합니다.")
+		}
+		if state := bm.APIState(); state != browser.BreakerClosed {
+			return health.Warn(fmt.Sprintf("%s API is recovering (%s)", name, state), "")
+		}
+		return health.OK(name + " API is reachable")
+	}})
+
+	r.Register(health.Check{Name: "account_store", Timeout: 10 * time.Second, Run: func(ctx context.Context) health.Outcome {
+		err := store.CheckSchema(ctx)
+		var schemaErr *db.SchemaError
+		switch {
+		case err == nil:
+			return health.OK(fmt.Sprintf("table %s is reachable", store.TableName()))
+		case errors.Is(err, applock.ErrLocked):
+			return health.Warn("account store credentials are locked", "마스터 패스프레이즈로 잠금을 해제하세요.")
+		case errors.As(err, &schemaErr) && schemaErr.Missing:
+			return health.Fail(err.Error(), "계정 테이블을 만드세요. (cookiebot accounts check -create)")
+		}
+		return health.Fail(err.Error(), "config.json 의 AWS 설정과 네트워크 연결을 확인하세요.")
+	}})
+
+	r.Register(health.DiskSpace("disk_space", dataDir, diskWarnBelow, diskFailBelow))
+
+	if logPath, err := utils.LogFilePath(); err == nil {
+		r.Register(health.Writable("log", logPath, "실행 파일 폴더에 쓰기 권한이 있는지 확인하세요. 권한이 없으면 로그가 남지 않습니다."))
+	}
+
+	return r
+}
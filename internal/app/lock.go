@@ -0,0 +1,72 @@
+// internal/app/lock.go
+
+package app
+
+import (
+	"time"
+
+	"cookieBot/internal/applock"
+
+	"go.uber.org/zap"
+)
+
+// LockStateChanged 는 앱 잠금 상태가 바뀔 때 발생하는 이벤트 이름입니다.
+const LockStateChanged = "app:lock"
+
+// LockService 는 마스터 패스프레이즈 앱 잠금을 제공합니다.
+type LockService struct {
+	logger *zap.Logger
+	lock   *applock.Lock
+}
+
+// Status 는 잠금 설정 여부, 잠김 여부, 자동 잠금 시간을 반환합니다.
+func (s *LockService) Status() applock.Status {
+	return s.lock.Status()
+}
+
+// Setup 은 첫 실행 시 마스터 패스프레이즈를 설정합니다.
+func (s *LockService) Setup(passphrase string) error {
+	if err := s.lock.Setup(passphrase); err != nil {
+		return err
+	}
+	s.logger.Info("Master passphrase configured")
+	return nil
+}
+
+// Unlock 은 마스터 패스프레이즈로 잠금을 풉니다.
+func (s *LockService) Unlock(passphrase string) error {
+	if err := s.lock.Unlock(passphrase); err != nil {
+		s.logger.Warn("Failed to unlock app", zap.Error(err))
+		return err
+	}
+	s.logger.Info("App unlocked")
+	return nil
+}
+
+// Lock 은 즉시 잠급니다.
+func (s *LockService) Lock() {
+	s.lock.LockNow()
+	s.logger.Info("App locked")
+}
+
+// ChangePassphrase 는 마스터 패스프레이즈를 바꿉니다.
+func (s *LockService) ChangePassphrase(current, next string) error {
+	if err := s.lock.ChangePassphrase(current, next); err != nil {
+		return err
+	}
+	s.logger.Info("Master passphrase changed")
+	return nil
+}
+
+// SetIdleTimeout 은 자동 잠금 시간(초)을 바꿉니다. 0 이면 자동으로 잠그지 않습니다.
+func (s *LockService) SetIdleTimeout(seconds int) error {
+	if seconds < 0 {
+		return invalidArgument("idle timeout must not be negative")
+	}
+	return s.lock.SetIdleTimeout(time.Duration(seconds) * time.Second)
+}
+
+// Touch 는 사용자 활동을 알려 자동 잠금을 늦춥니다. 프론트엔드가 입력이 있을 때 주기적으로 호출합니다.
+func (s *LockService) Touch() {
+	s.lock.Touch()
+}
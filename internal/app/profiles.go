@@ -0,0 +1,368 @@
+// internal/app/profiles.go
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"cookieBot/internal/apperr"
+	"cookieBot/internal/browser"
+	"cookieBot/internal/cdp"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"go.uber.org/zap"
+)
+
+// ProfileService 는 브라우저 프로필 관련 UI 동작을 제공합니다.
+type ProfileService struct {
+	ctx     context.Context
+	uiCtx   context.Context
+	logger  *zap.Logger
+	browser *browser.BrowserManager
+	// 선택한 브라우저 공급자를 저장하는 파일
+	providerPath string
+
+	// 진행 중인 일괄 작업의 취소 함수
+	bulkMu      sync.Mutex
+	bulkCancels map[int]context.CancelFunc
+	nextBulkID  int
+}
+
+const (
+	// 한 번에 조회할 수 있는 최대 프로필 수
+	maxPageSize = 500
+	// 일괄 작업의 최대 동시 실행 수
+	maxBulkConcurrency = 16
+)
+
+func newProfileService(ctx context.Context, logger *zap.Logger, bm *browser.BrowserManager, providerPath string) *ProfileService {
+	return &ProfileService{
+		ctx:          ctx,
+		logger:       logger,
+		browser:      bm,
+		providerPath: providerPath,
+		bulkCancels:  make(map[int]context.CancelFunc),
+	}
+}
+
+var bundleFileFilters = []runtime.FileFilter{{DisplayName: "Profile backup (*.json)", Pattern: "*.json"}}
+
+// ListProfiles 는 모든 프로필을 반환합니다.
+func (s *ProfileService) ListProfiles() (*browser.ProfileResponse, error) {
+	return s.browser.FetchProfiles()
+}
+
+// APIState 는 브라우저 API 연결 상태를 반환합니다. "closed" 가 아니면 재연결 중입니다.
+func (s *ProfileService) APIState() browser.BreakerState {
+	return s.browser.APIState()
+}
+
+// Providers 는 지원하는 브라우저 공급자와 각 공급자의 기능, 현재 선택 여부를 반환합니다.
+func (s *ProfileService) Providers() []browser.ProviderInfo {
+	return s.browser.Providers()
+}
+
+// SetProvider 는 브라우저 공급자를 바꾸고 다음 실행에도 유지합니다.
+func (s *ProfileService) SetProvider(name string) error {
+	if err := s.browser.UseProvider(name); err != nil {
+		return err
+	}
+	if err := browser.SaveProviderSetting(s.providerPath, name); err != nil {
+		s.logger.Error("Failed to save provider setting", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ProviderAccount 는 공급자 앱에 로그인한 계정 정보를 반환합니다. 지원하지 않는 공급자는 오류를 반환합니다.
+func (s *ProfileService) ProviderAccount(name string) (map[string]interface{}, error) {
+	return s.browser.ProviderAccount(name)
+}
+
+// GetProfile 은 프로필 하나의 상세 정보를 반환합니다.
+func (s *ProfileService) GetProfile(profileID string) (*browser.ProfileInfoResponse, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return nil, err
+	}
+	return s.browser.FetchProfileInfo(profileID)
+}
+
+// CreateProfile 은 새 프로필을 만듭니다.
+func (s *ProfileService) CreateProfile(req browser.CreateProfileRequest) (map[string]interface{}, error) {
+	if err := validateProfileRequest(req); err != nil {
+		return nil, err
+	}
+	if err := s.browser.CheckProfileOptions(req); err != nil {
+		return nil, err
+	}
+	return s.browser.AddProfile(req)
+}
+
+// UpdateProfile 은 프로필 설정을 수정합니다.
+func (s *ProfileService) UpdateProfile(profileID string, req browser.CreateProfileRequest) (map[string]interface{}, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return nil, err
+	}
+	if err := validateProfileRequest(req); err != nil {
+		return nil, err
+	}
+	return s.browser.ModifyProfile(profileID, req)
+}
+
+// StartProfile 은 프로필을 실행합니다.
+func (s *ProfileService) StartProfile(profileID string) (map[string]interface{}, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return nil, err
+	}
+	return s.browser.LaunchProfile(profileID)
+}
+
+// StopProfile 은 실행 중인 프로필을 종료합니다.
+func (s *ProfileService) StopProfile(profileID string) (map[string]interface{}, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return nil, err
+	}
+	return s.browser.StopProfile(profileID)
+}
+
+// DeleteProfile 은 프로필을 삭제합니다.
+func (s *ProfileService) DeleteProfile(profileID string) (map[string]interface{}, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return nil, err
+	}
+	return s.browser.RemoveProfile(profileID)
+}
+
+// ExportProfiles 는 선택한 프로필을 저장 대화상자에서 고른 파일로 백업합니다.
+// passphrase 가 있으면 비밀 정보를 암호화합니다. 사용자가 취소하면 빈 경로를 반환합니다.
+func (s *ProfileService) ExportProfiles(profileIDs []string, passphrase string) (string, error) {
+	if err := validateProfileIDs(profileIDs); err != nil {
+		return "", err
+	}
+
+	path, err := runtime.SaveFileDialog(s.uiCtx, runtime.SaveDialogOptions{
+		Title:           "프로필 백업",
+		DefaultFilename: fmt.Sprintf("profiles-%s.json", time.Now().Format("20060102")),
+		Filters:         bundleFileFilters,
+	})
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	data, err := s.browser.ExportProfiles(profileIDs, passphrase)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to write profile backup: %w", err)
+	}
+	return path, nil
+}
+
+// ImportProfiles 는 열기 대화상자에서 고른 백업 파일의 프로필을 만듭니다.
+// conflict 는 skip, rename, overwrite 중 하나입니다. 사용자가 취소하면 nil 을 반환합니다.
+func (s *ProfileService) ImportProfiles(passphrase string, conflict string) ([]browser.ImportResult, error) {
+	path, err := runtime.OpenFileDialog(s.uiCtx, runtime.OpenDialogOptions{
+		Title:   "프로필 복원",
+		Filters: bundleFileFilters,
+	})
+	if err != nil || path == "" {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile backup: %w", err)
+	}
+	return s.browser.ImportProfiles(data, passphrase, conflict)
+}
+
+// QueryProfiles 는 조건에 맞는 프로필을 정렬된 목록으로 반환합니다.
+func (s *ProfileService) QueryProfiles(query browser.ProfileQuery) (*browser.ProfilePage, error) {
+	if query.Limit > maxPageSize {
+		return nil, invalidArgument("limit must not exceed %d", maxPageSize)
+	}
+	return s.browser.QueryProfiles(query)
+}
+
+// ListFoldersAndTags 는 전체 폴더와 태그를 프로필 수와 함께 반환합니다.
+func (s *ProfileService) ListFoldersAndTags() (*browser.ProfileTaxonomy, error) {
+	return s.browser.ListFoldersAndTags()
+}
+
+// MoveProfiles 는 선택한 프로필을 다른 폴더로 옮깁니다.
+func (s *ProfileService) MoveProfiles(profileIDs []string, folder string) ([]browser.ProfileUpdateResult, error) {
+	if err := validateProfileIDs(profileIDs); err != nil {
+		return nil, err
+	}
+	return s.browser.MoveProfiles(profileIDs, folder)
+}
+
+// AddTags 는 선택한 프로필에 태그를 추가합니다.
+func (s *ProfileService) AddTags(profileIDs []string, tags []string) ([]browser.ProfileUpdateResult, error) {
+	if err := validateProfileIDs(profileIDs); err != nil {
+		return nil, err
+	}
+	return s.browser.AddTags(profileIDs, tags)
+}
+
+// RemoveTags 는 선택한 프로필에서 태그를 제거합니다.
+func (s *ProfileService) RemoveTags(profileIDs []string, tags []string) ([]browser.ProfileUpdateResult, error) {
+	if err := validateProfileIDs(profileIDs); err != nil {
+		return nil, err
+	}
+	return s.browser.RemoveTags(profileIDs, tags)
+}
+
+// RenameTag 는 모든 프로필에서 태그 이름을 바꿉니다.
+func (s *ProfileService) RenameTag(oldName, newName string) ([]browser.ProfileUpdateResult, error) {
+	return s.browser.RenameTag(oldName, newName)
+}
+
+// StartProfiles 는 여러 프로필을 동시에 실행하고 프로필별 결과를 반환합니다.
+// 항목이 끝날 때마다 "profiles:bulk-progress" 이벤트를 보냅니다.
+func (s *ProfileService) StartProfiles(profileIDs []string, concurrency int) ([]browser.BulkResult, error) {
+	return s.runBulk(profileIDs, concurrency, s.browser.LaunchProfiles)
+}
+
+// StopProfiles 는 여러 프로필을 동시에 종료합니다.
+func (s *ProfileService) StopProfiles(profileIDs []string, concurrency int) ([]browser.BulkResult, error) {
+	return s.runBulk(profileIDs, concurrency, s.browser.StopProfiles)
+}
+
+// DeleteProfiles 는 여러 프로필을 동시에 삭제합니다.
+func (s *ProfileService) DeleteProfiles(profileIDs []string, concurrency int) ([]browser.BulkResult, error) {
+	return s.runBulk(profileIDs, concurrency, s.browser.RemoveProfiles)
+}
+
+// CancelBulkOperations 는 진행 중인 모든 일괄 작업을 취소합니다. 이미 시작된 요청은 끝까지 기다리지 않습니다.
+func (s *ProfileService) CancelBulkOperations() {
+	s.bulkMu.Lock()
+	defer s.bulkMu.Unlock()
+	for _, cancel := range s.bulkCancels {
+		cancel()
+	}
+}
+
+func (s *ProfileService) runBulk(profileIDs []string, concurrency int,
+	run func(context.Context, []string, browser.BulkOptions) []browser.BulkResult) ([]browser.BulkResult, error) {
+	if err := validateProfileIDs(profileIDs); err != nil {
+		return nil, err
+	}
+	if concurrency < 0 || concurrency > maxBulkConcurrency {
+		return nil, invalidArgument("concurrency must be between 0 (default) and %d", maxBulkConcurrency)
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.bulkMu.Lock()
+	id := s.nextBulkID
+	s.nextBulkID++
+	s.bulkCancels[id] = cancel
+	s.bulkMu.Unlock()
+
+	defer func() {
+		s.bulkMu.Lock()
+		delete(s.bulkCancels, id)
+		s.bulkMu.Unlock()
+		cancel()
+	}()
+
+	return run(ctx, profileIDs, browser.BulkOptions{
+		Concurrency: concurrency,
+		OnProgress: func(p browser.BulkProgress) {
+			runtime.EventsEmit(s.uiCtx, "profiles:bulk-progress", p)
+		},
+	}), nil
+}
+
+var cookieFileFilters = []runtime.FileFilter{{DisplayName: "Cookies (*.txt, *.json)", Pattern: "*.txt;*.json"}}
+
+// ImportCookies 는 열기 대화상자에서 고른 cookies.txt 또는 JSON 파일의 쿠키를 프로필에 설정합니다.
+// 사용자가 취소하면 0 을 반환합니다.
+func (s *ProfileService) ImportCookies(profileID string) (int, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return 0, err
+	}
+
+	path, err := runtime.OpenFileDialog(s.uiCtx, runtime.OpenDialogOptions{
+		Title:   "쿠키 가져오기",
+		Filters: cookieFileFilters,
+	})
+	if err != nil || path == "" {
+		return 0, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read cookie file: %w", err)
+	}
+	cookies, err := browser.ParseCookies(data)
+	if err != nil {
+		return 0, apperr.Wrap(apperr.ImportInvalid, err)
+	}
+	// 빈 목록을 보내면 프로필의 기존 쿠키가 모두 지워질 수 있습니다.
+	if len(cookies) == 0 {
+		return 0, apperr.Wrap(apperr.ImportInvalid, fmt.Errorf("no cookies found in %s", filepath.Base(path)))
+	}
+
+	if _, err := s.browser.ApplyProfileDiff(profileID, browser.ProfileDiff{Cookies: &cookies}); err != nil {
+		return 0, err
+	}
+	return len(cookies), nil
+}
+
+// ExportCookies 는 실행 중인 프로필의 쿠키를 DevTools 로 읽어 format(netscape, json) 파일로 저장합니다.
+// 사용자가 취소하면 빈 경로를 반환합니다.
+func (s *ProfileService) ExportCookies(profileID string, format string) (string, error) {
+	if err := validateProfileID(profileID); err != nil {
+		return "", err
+	}
+	ext := ".txt"
+	switch format {
+	case browser.CookieFormatNetscape:
+	case browser.CookieFormatJSON:
+		ext = ".json"
+	default:
+		return "", invalidArgument("unknown cookie format %q", format)
+	}
+
+	info, err := s.browser.FetchProfileInfo(profileID)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, 30*time.Second)
+	defer cancel()
+	client, err := cdp.Attach(ctx, info.Data, s.logger)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	cookies, err := client.Cookies(ctx)
+	if err != nil {
+		return "", err
+	}
+	data, err := browser.FormatCookies(cookies, format)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := runtime.SaveFileDialog(s.uiCtx, runtime.SaveDialogOptions{
+		Title:           "쿠키 내보내기",
+		DefaultFilename: "cookies-" + profileID + ext,
+		Filters:         cookieFileFilters,
+	})
+	if err != nil || path == "" {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to write cookie file: %w", err)
+	}
+	return path, nil
+}
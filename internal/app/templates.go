@@ -0,0 +1,65 @@
+// internal/app/templates.go
+
+package app
+
+import (
+	"cookieBot/internal/browser"
+
+	"go.uber.org/zap"
+)
+
+// TemplateService 는 프로필 템플릿 관리와 템플릿 기반 프로필 생성을 제공합니다.
+type TemplateService struct {
+	logger  *zap.Logger
+	store   *browser.TemplateStore
+	browser *browser.BrowserManager
+}
+
+// ListTemplates 는 저장된 템플릿을 이름 순으로 반환합니다.
+func (s *TemplateService) ListTemplates() ([]browser.ProfileTemplate, error) {
+	return s.store.List()
+}
+
+// GetTemplate 은 이름으로 템플릿을 반환합니다.
+func (s *TemplateService) GetTemplate(name string) (*browser.ProfileTemplate, error) {
+	return s.store.Get(name)
+}
+
+// SaveTemplate 은 템플릿을 만들거나 같은 이름의 템플릿을 덮어씁니다. 프로필 옵션은 현재 공급자가
+// 받는 값인지 확인합니다.
+func (s *TemplateService) SaveTemplate(t browser.ProfileTemplate) error {
+	if err := s.browser.CheckProfileOptions(t.Profile); err != nil {
+		return err
+	}
+	if err := s.store.Save(t); err != nil {
+		s.logger.Error("Failed to save profile template", zap.String("name", t.Name), zap.Error(err))
+		return err
+	}
+	s.logger.Info("Profile template saved", zap.String("name", t.Name))
+	return nil
+}
+
+// DeleteTemplate 은 템플릿을 삭제합니다.
+func (s *TemplateService) DeleteTemplate(name string) error {
+	if err := s.store.Delete(name); err != nil {
+		return err
+	}
+	s.logger.Info("Profile template deleted", zap.String("name", name))
+	return nil
+}
+
+// CreateFromTemplate 은 템플릿에 overrides 를 덮어써 count 개의 프로필을 만듭니다.
+// overrides.Name 을 비워 두면 템플릿의 이름 패턴(예: "qa-{n}")을 사용합니다.
+func (s *TemplateService) CreateFromTemplate(name string, count int, overrides browser.CreateProfileRequest) ([]browser.TemplateCreateResult, error) {
+	if count < 1 || count > browser.MaxTemplateCount {
+		return nil, invalidArgument("count must be between 1 and %d", browser.MaxTemplateCount)
+	}
+	if overrides.CPU < 0 || overrides.Memory < 0 {
+		return nil, invalidArgument("cpu and memory must not be negative")
+	}
+	t, err := s.store.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.browser.CreateFromTemplate(*t, count, overrides)
+}
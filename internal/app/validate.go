@@ -0,0 +1,54 @@
+// internal/app/validate.go
+
+package app
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"cookieBot/internal/browser"
+)
+
+// 프로필 ID 는 URL 경로에 그대로 들어가므로 안전한 문자만 허용합니다.
+var profileIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+func validateProfileID(id string) error {
+	if !profileIDPattern.MatchString(id) {
+		return invalidArgument("invalid profile id %q", id)
+	}
+	return nil
+}
+
+func validateProfileIDs(ids []string) error {
+	if len(ids) == 0 {
+		return invalidArgument("no profiles selected")
+	}
+	for _, id := range ids {
+		if err := validateProfileID(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEmail(email string) error {
+	if strings.TrimSpace(email) == "" {
+		return invalidArgument("email is required")
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return invalidArgument("invalid email address %q", email)
+	}
+	return nil
+}
+
+func validateProfileRequest(req browser.CreateProfileRequest) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return invalidArgument("profile name is required")
+	}
+	if req.CPU < 0 || req.Memory < 0 {
+		return invalidArgument("cpu and memory must not be negative")
+	}
+	return nil
+}
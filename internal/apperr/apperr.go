@@ -0,0 +1,123 @@
+// internal/apperr/apperr.go
+
+package apperr
+
+import (
+	"errors"
+	"strings"
+)
+
+// Code 는 프론트엔드가 분기에 사용하는 안정적인 오류 코드입니다. 한 번 공개한 값은 바꾸지 않습니다.
+type Code string
+
+const (
+	Internal        Code = "INTERNAL"
+	InvalidArgument Code = "INVALID_ARGUMENT"
+	Cancelled       Code = "CANCELLED"
+	FileAccess      Code = "FILE_ACCESS_FAILED"
+	DecryptFailed   Code = "DECRYPT_FAILED"
+	ImportInvalid   Code = "IMPORT_INVALID"
+
+	// 앱 잠금
+	AppLocked         Code = "APP_LOCKED"
+	WrongPassphrase   Code = "WRONG_PASSPHRASE"
+	LockNotConfigured Code = "LOCK_NOT_CONFIGURED"
+
+	// 브라우저 공급자
+	BrowserAPIUnavailable Code = "BROWSER_API_UNAVAILABLE"
+	ProviderUnsupported   Code = "PROVIDER_UNSUPPORTED"
+	ProfileNotFound       Code = "PROFILE_NOT_FOUND"
+	ProfileNotRunning     Code = "PROFILE_NOT_RUNNING"
+	TemplateNotFound      Code = "TEMPLATE_NOT_FOUND"
+
+	// 계정 저장소와 비밀 정보
+	AccountNotFound    Code = "ACCOUNT_NOT_FOUND"
+	StoreConflict      Code = "STORE_CONFLICT"
+	StoreSchemaInvalid Code = "STORE_SCHEMA_INVALID"
+	SecretNotFound     Code = "SECRET_NOT_FOUND"
+	AuditUnavailable   Code = "AUDIT_UNAVAILABLE"
+
+	// 다운로드와 설치
+	DownloadFailed           Code = "DOWNLOAD_FAILED"
+	DownloadChecksumMismatch Code = "DOWNLOAD_CHECKSUM_MISMATCH"
+	InstallCheckFailed       Code = "INSTALL_CHECK_FAILED"
+	InstallFailed            Code = "INSTALL_FAILED"
+	InstallCancelled         Code = "INSTALL_CANCELLED"
+	LaunchFailed             Code = "LAUNCH_FAILED"
+)
+
+// Key 는 코드에 해당하는 메시지 카탈로그 키입니다 (예: "error.profile_not_found").
+func (c Code) Key() string {
+	return "error." + strings.ToLower(string(c))
+}
+
+// Error 는 코드, 메시지 키와 매개변수, 원인 오류를 담은 오류입니다.
+// Error() 는 로그용 영어 메시지이며, 사용자에게는 Localize 로 만든 메시지를 보여 줍니다.
+type Error struct {
+	Code   Code
+	Key    string
+	Params map[string]string
+	Cause  error
+}
+
+// New 는 원인 없이 code 오류를 만듭니다.
+func New(code Code) *Error {
+	return &Error{Code: code, Key: code.Key()}
+}
+
+// Wrap 은 cause 를 code 오류로 감쌉니다. cause 가 이미 *Error 이면 바깥 코드가 우선합니다.
+func Wrap(code Code, cause error) *Error {
+	return &Error{Code: code, Key: code.Key(), Cause: cause}
+}
+
+// With 는 메시지의 {name} 자리에 들어갈 값을 추가합니다.
+func (e *Error) With(name, value string) *Error {
+	if e.Params == nil {
+		e.Params = make(map[string]string)
+	}
+	e.Params[name] = value
+	return e
+}
+
+func (e *Error) Error() string {
+	msg := Message(English, e.Key, e.Params)
+	if e.Cause == nil {
+		return msg
+	}
+	return strings.TrimSuffix(msg, ".") + ": " + e.Cause.Error()
+}
+
+func (e *Error) Unwrap() error { return e.Cause }
+
+// Is 는 코드가 같은 *Error 와 일치합니다. errors.Is(err, apperr.New(apperr.AppLocked)) 처럼 씁니다.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// CodeOf 는 err 사슬에서 가장 바깥 *Error 의 코드를 반환합니다. 없으면 Internal 입니다.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return Internal
+}
+
+// Payload 는 프론트엔드로 보내는 오류 형태입니다. Cause 는 진단용 원문입니다.
+type Payload struct {
+	Code    Code              `json:"code"`
+	Key     string            `json:"key"`
+	Params  map[string]string `json:"params,omitempty"`
+	Message string            `json:"message"`
+	Cause   string            `json:"cause,omitempty"`
+}
+
+// Localize 는 lang 메시지로 Payload 를 만듭니다.
+func (e *Error) Localize(lang string) Payload {
+	p := Payload{Code: e.Code, Key: e.Key, Params: e.Params, Message: Message(lang, e.Key, e.Params)}
+	if e.Cause != nil {
+		p.Cause = e.Cause.Error()
+	}
+	return p
+}
@@ -0,0 +1,114 @@
+// internal/apperr/catalog.go
+
+package apperr
+
+import "strings"
+
+// 지원하는 메시지 언어
+const (
+	Korean  = "ko"
+	English = "en"
+)
+
+// DefaultLanguage 는 UI 기본 언어입니다.
+const DefaultLanguage = Korean
+
+var catalogs = map[string]map[string]string{
+	Korean: {
+		Internal.Key():        "알 수 없는 오류가 발생했습니다.",
+		InvalidArgument.Key(): "입력값이 올바르지 않습니다.",
+		Cancelled.Key():       "작업이 취소되었습니다.",
+		FileAccess.Key():      "파일을 읽거나 쓸 수 없습니다.",
+		DecryptFailed.Key():   "복호화에 실패했습니다. 패스프레이즈를 확인하세요.",
+		ImportInvalid.Key():   "가져올 파일의 형식이 올바르지 않습니다.",
+
+		AppLocked.Key():         "앱이 잠겨 있습니다. 마스터 패스프레이즈로 잠금을 해제하세요.",
+		WrongPassphrase.Key():   "마스터 패스프레이즈가 틀렸습니다.",
+		LockNotConfigured.Key(): "마스터 패스프레이즈가 설정되지 않았습니다.",
+
+		BrowserAPIUnavailable.Key(): "브라우저 API 에 연결할 수 없습니다. {provider} 실행 여부를 확인하세요.",
+		ProviderUnsupported.Key():   "현재 브라우저 공급자({provider})는 이 기능을 지원하지 않습니다.",
+		ProfileNotFound.Key():       "프로필을 찾을 수 없습니다.",
+		ProfileNotRunning.Key():     "프로필이 실행 중이 아닙니다. 먼저 프로필을 실행하세요.",
+		TemplateNotFound.Key():      "템플릿을 찾을 수 없습니다.",
+
+		AccountNotFound.Key():    "계정을 찾을 수 없습니다.",
+		StoreConflict.Key():      "다른 곳에서 계정이 수정되었습니다. 새로 고친 뒤 다시 시도하세요.",
+		StoreSchemaInvalid.Key(): "계정 저장소 구성이 올바르지 않습니다.",
+		SecretNotFound.Key():     "비밀 값을 찾을 수 없습니다.",
+		AuditUnavailable.Key():   "감사 로그를 사용할 수 없습니다.",
+
+		DownloadFailed.Key():           "{component} 다운로드에 실패했습니다.",
+		DownloadChecksumMismatch.Key(): "{component} 다운로드 파일의 체크섬이 일치하지 않습니다.",
+		InstallCheckFailed.Key():       "{component} 설치 상태를 확인하지 못했습니다.",
+		InstallFailed.Key():            "{component} 설치에 실패했습니다.",
+		InstallCancelled.Key():         "{component} 설치가 취소되었습니다.",
+		LaunchFailed.Key():             "{component} 실행에 실패했습니다.",
+	},
+	English: {
+		Internal.Key():        "An unexpected error occurred.",
+		InvalidArgument.Key(): "Invalid input.",
+		Cancelled.Key():       "The operation was cancelled.",
+		FileAccess.Key():      "The file could not be read or written.",
+		DecryptFailed.Key():   "Decryption failed. Check the passphrase.",
+		ImportInvalid.Key():   "The import file is not in a valid format.",
+
+		AppLocked.Key():         "The app is locked. Unlock it with the master passphrase.",
+		WrongPassphrase.Key():   "Wrong master passphrase.",
+		LockNotConfigured.Key(): "The master passphrase is not set.",
+
+		BrowserAPIUnavailable.Key(): "Cannot reach the browser API. Make sure {provider} is running.",
+		ProviderUnsupported.Key():   "The current browser provider ({provider}) does not support this.",
+		ProfileNotFound.Key():       "Profile not found.",
+		ProfileNotRunning.Key():     "The profile is not running. Start it first.",
+		TemplateNotFound.Key():      "Template not found.",
+
+		AccountNotFound.Key():    "Account not found.",
+		StoreConflict.Key():      "The account was modified elsewhere. Refresh and try again.",
+		StoreSchemaInvalid.Key(): "The account store is not configured correctly.",
+		SecretNotFound.Key():     "Secret not found.",
+		AuditUnavailable.Key():   "The audit log is not available.",
+
+		DownloadFailed.Key():           "Failed to download {component}.",
+		DownloadChecksumMismatch.Key(): "The {component} download failed checksum verification.",
+		InstallCheckFailed.Key():       "Failed to check the {component} installation.",
+		InstallFailed.Key():            "Failed to install {component}.",
+		InstallCancelled.Key():         "The {component} installation was cancelled.",
+		LaunchFailed.Key():             "Failed to start {component}.",
+	},
+}
+
+// Languages 는 카탈로그가 있는 언어 목록입니다.
+func Languages() []string {
+	return []string{Korean, English}
+}
+
+// Catalog 는 lang 의 메시지 카탈로그 복사본을 반환합니다. 없는 언어이면 기본 언어입니다.
+func Catalog(lang string) map[string]string {
+	src, ok := catalogs[lang]
+	if !ok {
+		src = catalogs[DefaultLanguage]
+	}
+	out := make(map[string]string, len(src))
+	for k, v := range src {
+		out[k] = v
+	}
+	return out
+}
+
+// Message 는 key 의 lang 메시지에 params 를 채워 반환합니다.
+// 없는 언어이면 기본 언어, 없는 키이면 Internal 메시지를 씁니다.
+func Message(lang, key string, params map[string]string) string {
+	catalog, ok := catalogs[lang]
+	if !ok {
+		catalog = catalogs[DefaultLanguage]
+	}
+	msg, ok := catalog[key]
+	if !ok {
+		msg = catalog[Internal.Key()]
+	}
+	for name, value := range params {
+		msg = strings.ReplaceAll(msg, "{"+name+"}", value)
+	}
+	return msg
+}
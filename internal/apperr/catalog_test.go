@@ -0,0 +1,86 @@
+// internal/apperr/catalog_test.go
+
+package apperr
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+)
+
+// declaredCodes 는 apperr.go 에 선언된 Code 상수 값을 모두 읽습니다. 목록을 따로 두지 않아
+// 새 코드를 추가하고 카탈로그를 빠뜨리면 TestCatalogCoversEveryCode 가 실패합니다.
+func declaredCodes(t *testing.T) []Code {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), "apperr.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parse apperr.go: %v", err)
+	}
+	var codes []Code
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if ident, ok := vs.Type.(*ast.Ident); !ok || ident.Name != "Code" {
+				continue
+			}
+			for _, v := range vs.Values {
+				lit, ok := v.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					t.Fatalf("Code constant %s is not a string literal", vs.Names[0].Name)
+				}
+				s, _ := strconv.Unquote(lit.Value)
+				codes = append(codes, Code(s))
+			}
+		}
+	}
+	return codes
+}
+
+func TestCatalogCoversEveryCode(t *testing.T) {
+	codes := declaredCodes(t)
+	if len(codes) < 20 {
+		t.Fatalf("found only %d Code constants in apperr.go", len(codes))
+	}
+
+	seen := make(map[Code]bool, len(codes))
+	keys := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if seen[code] {
+			t.Errorf("code %s is declared twice", code)
+		}
+		seen[code] = true
+		keys[code.Key()] = true
+		for _, lang := range Languages() {
+			if msg := catalogs[lang][code.Key()]; msg == "" {
+				t.Errorf("%s catalog has no message for %s", lang, code)
+			}
+		}
+	}
+	// 카탈로그에만 있고 코드가 없는 키도 남지 않아야 합니다.
+	for _, lang := range Languages() {
+		for key := range catalogs[lang] {
+			if !keys[key] {
+				t.Errorf("%s catalog has %s but no Code declares it", lang, key)
+			}
+		}
+	}
+}
+
+func TestMessageParams(t *testing.T) {
+	err := Wrap(DownloadChecksumMismatch, nil).With("component", "VMware")
+	if got := err.Localize(Korean).Message; got != "VMware 다운로드 파일의 체크섬이 일치하지 않습니다." {
+		t.Fatalf("ko message = %q", got)
+	}
+	if got := err.Localize(English).Message; got != "The VMware download failed checksum verification." {
+		t.Fatalf("en message = %q", got)
+	}
+	if got := Message("fr", "error.unknown", nil); got != catalogs[DefaultLanguage][Internal.Key()] {
+		t.Fatalf("unknown language and key message = %q", got)
+	}
+}
@@ -0,0 +1,310 @@
+// internal/applock/applock.go
+
+package applock
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"cookieBot/utils"
+)
+
+// 잠금 확인용으로 암호화해 두는 고정 값
+var verifier = []byte("cookiebot-applock-v1")
+
+const (
+	// DefaultIdleTimeout 은 입력이 없을 때 자동으로 잠그기까지의 기본 시간입니다.
+	DefaultIdleTimeout = 15 * time.Minute
+	// MinPassphraseLength 는 마스터 패스프레이즈 최소 길이입니다.
+	MinPassphraseLength = 8
+)
+
+// 자동 잠금 확인 주기. 테스트에서 줄입니다.
+var checkInterval = 10 * time.Second
+
+var (
+	// ErrLocked 는 잠긴 상태에서 비밀 정보를 요청했을 때 반환됩니다.
+	ErrLocked = errors.New("app is locked; unlock with the master passphrase")
+	// ErrWrongPassphrase 는 마스터 패스프레이즈가 틀렸을 때 반환됩니다.
+	ErrWrongPassphrase = errors.New("wrong master passphrase")
+	// ErrNotConfigured 는 마스터 패스프레이즈가 아직 설정되지 않았을 때 반환됩니다.
+	ErrNotConfigured = errors.New("master passphrase is not set")
+	// ErrPassphraseTooShort 는 새 패스프레이즈가 MinPassphraseLength 보다 짧을 때 반환됩니다.
+	ErrPassphraseTooShort = errors.New("master passphrase is too short")
+)
+
+// Status 는 UI 에 보여 줄 잠금 상태입니다.
+type Status struct {
+	Configured         bool `json:"configured"`
+	Locked             bool `json:"locked"`
+	IdleTimeoutSeconds int  `json:"idle_timeout_seconds"`
+}
+
+// lockFile 은 디스크에 저장되는 잠금 설정입니다. 패스프레이즈나 키는 저장하지 않고,
+// 유도한 키로 고정 값을 암호화한 결과만 저장해 패스프레이즈가 맞는지 확인합니다.
+type lockFile struct {
+	KDF                utils.KDFParams `json:"kdf"`
+	Check              []byte          `json:"check"`
+	IdleTimeoutSeconds int             `json:"idle_timeout_seconds"`
+}
+
+// Lock 은 마스터 패스프레이즈로 여는 앱 잠금입니다. 잠금이 풀린 동안에만 유도한 키를 메모리에 둡니다.
+type Lock struct {
+	mu           sync.Mutex
+	path         string
+	file         *lockFile
+	key          []byte
+	lastActivity time.Time
+	onChange     func(Status)
+	onRekey      func(oldKey, newKey []byte) error
+}
+
+// Open 은 path 의 잠금 설정을 읽습니다. 파일이 없으면 설정되지 않은 상태로 시작합니다 (첫 실행).
+func Open(path string) (*Lock, error) {
+	l := &Lock{path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read app lock: %w", err)
+	}
+	var f lockFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse app lock: %w", err)
+	}
+	l.file = &f
+	return l, nil
+}
+
+// OnChange 는 잠금 상태가 바뀔 때 호출할 함수를 등록합니다.
+func (l *Lock) OnChange(fn func(Status)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.onChange = fn
+}
+
+// OnRekey 는 패스프레이즈를 바꿀 때 이전 키로 암호화된 데이터를 새 키로 다시 암호화할 함수를 등록합니다.
+// fn 이 실패하면 패스프레이즈는 바뀌지 않습니다.
+func (l *Lock) OnRekey(fn func(oldKey, newKey []byte) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.onRekey = fn
+}
+
+// Status 는 현재 잠금 상태를 반환합니다.
+func (l *Lock) Status() Status {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.status()
+}
+
+// Setup 은 첫 실행 시 마스터 패스프레이즈를 설정하고 잠금을 풉니다.
+func (l *Lock) Setup(passphrase string) error {
+	if len(passphrase) < MinPassphraseLength {
+		return fmt.Errorf("%w: at least %d characters", ErrPassphraseTooShort, MinPassphraseLength)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file != nil {
+		return fmt.Errorf("master passphrase is already set")
+	}
+	return l.write(passphrase, int(DefaultIdleTimeout/time.Second), nil)
+}
+
+// Unlock 은 패스프레이즈를 확인하고 잠금을 풉니다.
+func (l *Lock) Unlock(passphrase string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return ErrNotConfigured
+	}
+
+	key := l.file.KDF.DeriveKey(passphrase)
+	plain, err := utils.Open(key, l.file.Check)
+	if err != nil || subtle.ConstantTimeCompare(plain, verifier) != 1 {
+		return ErrWrongPassphrase
+	}
+	l.key = key
+	l.lastActivity = time.Now()
+	l.notify()
+	return nil
+}
+
+// ChangePassphrase 는 현재 패스프레이즈를 확인한 뒤 새 패스프레이즈로 바꿉니다.
+func (l *Lock) ChangePassphrase(current, next string) error {
+	if len(next) < MinPassphraseLength {
+		return fmt.Errorf("%w: at least %d characters", ErrPassphraseTooShort, MinPassphraseLength)
+	}
+	if err := l.Unlock(current); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.write(next, l.file.IdleTimeoutSeconds, append([]byte(nil), l.key...))
+}
+
+// LockNow 는 즉시 잠그고 메모리의 키를 지웁니다.
+func (l *Lock) LockNow() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.key == nil {
+		return
+	}
+	l.clearKey()
+	l.notify()
+}
+
+// SetIdleTimeout 은 자동 잠금 시간을 바꿉니다. 0 이면 자동으로 잠그지 않습니다. 잠금이 풀린 상태에서만 바꿀 수 있습니다.
+func (l *Lock) SetIdleTimeout(d time.Duration) error {
+	if d < 0 {
+		return fmt.Errorf("idle timeout must not be negative")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.key == nil {
+		return ErrLocked
+	}
+	f := *l.file
+	f.IdleTimeoutSeconds = int(d / time.Second)
+	if err := l.save(&f); err != nil {
+		return err
+	}
+	l.file = &f
+	l.notify()
+	return nil
+}
+
+// Touch 는 사용자 활동을 기록해 자동 잠금 시간을 늦춥니다.
+func (l *Lock) Touch() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.key != nil {
+		l.lastActivity = time.Now()
+	}
+}
+
+// Require 는 잠금이 풀려 있으면 활동을 기록하고 nil 을, 잠겨 있으면 ErrLocked 를 반환합니다.
+// 비밀 정보를 반환하는 메서드의 시작에서 호출합니다.
+func (l *Lock) Require() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.key == nil {
+		return ErrLocked
+	}
+	l.lastActivity = time.Now()
+	return nil
+}
+
+// Key 는 잠금이 풀린 동안 마스터 패스프레이즈에서 유도한 키의 복사본을 반환합니다.
+func (l *Lock) Key() ([]byte, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.key == nil {
+		return nil, ErrLocked
+	}
+	return append([]byte(nil), l.key...), nil
+}
+
+// Run 은 ctx 가 취소될 때까지 유휴 시간을 확인해 자동으로 잠급니다.
+func (l *Lock) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			l.LockNow()
+			return
+		case now := <-ticker.C:
+			l.lockIfIdle(now)
+		}
+	}
+}
+
+// lockIfIdle 은 now 기준으로 마지막 활동 뒤 자동 잠금 시간이 지났으면 잠급니다.
+func (l *Lock) lockIfIdle(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.key != nil && l.file.IdleTimeoutSeconds > 0 &&
+		now.Sub(l.lastActivity) >= time.Duration(l.file.IdleTimeoutSeconds)*time.Second {
+		l.clearKey()
+		l.notify()
+	}
+}
+
+// write 는 새 패스프레이즈로 잠금 파일을 만들고 잠금을 풉니다. oldKey 가 있으면 OnRekey 로 등록된 데이터를
+// 먼저 새 키로 옮깁니다. mu 를 잡은 상태에서 호출해야 합니다.
+func (l *Lock) write(passphrase string, idleTimeoutSeconds int, oldKey []byte) error {
+	kdf, err := utils.NewKDFParams()
+	if err != nil {
+		return err
+	}
+	key := kdf.DeriveKey(passphrase)
+	check, err := utils.Seal(key, verifier)
+	if err != nil {
+		return err
+	}
+
+	rekey := oldKey != nil && l.onRekey != nil
+	if rekey {
+		if err := l.onRekey(oldKey, key); err != nil {
+			return fmt.Errorf("failed to re-encrypt secrets: %w", err)
+		}
+	}
+
+	f := &lockFile{KDF: kdf, Check: check, IdleTimeoutSeconds: idleTimeoutSeconds}
+	if err := l.save(f); err != nil {
+		if rekey {
+			// 잠금 파일은 이전 패스프레이즈 그대로이므로 데이터도 이전 키로 되돌립니다.
+			l.onRekey(key, oldKey)
+		}
+		return err
+	}
+	l.file = f
+	l.key = key
+	l.lastActivity = time.Now()
+	l.notify()
+	return nil
+}
+
+func (l *Lock) save(f *lockFile) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := utils.WriteFileAtomic(l.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write app lock: %w", err)
+	}
+	return nil
+}
+
+func (l *Lock) clearKey() {
+	for i := range l.key {
+		l.key[i] = 0
+	}
+	l.key = nil
+}
+
+func (l *Lock) status() Status {
+	s := Status{Configured: l.file != nil, Locked: l.key == nil}
+	if l.file != nil {
+		s.IdleTimeoutSeconds = l.file.IdleTimeoutSeconds
+	}
+	return s
+}
+
+// notify 는 mu 를 잡은 상태에서 호출합니다. 콜백은 잠금 밖에서 실행됩니다.
+func (l *Lock) notify() {
+	if l.onChange != nil {
+		go l.onChange(l.status())
+	}
+}
@@ -0,0 +1,269 @@
+// internal/applock/applock_test.go
+
+package applock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testPassphrase = "correct horse"
+
+func newTestLock(t *testing.T) (*Lock, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "applock.json")
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return l, path
+}
+
+func TestSetupFirstRun(t *testing.T) {
+	l, path := newTestLock(t)
+	if s := l.Status(); s.Configured || !s.Locked {
+		t.Fatalf("status before setup = %+v, want unconfigured and locked", s)
+	}
+	if err := l.Unlock(testPassphrase); !errors.Is(err, ErrNotConfigured) {
+		t.Fatalf("Unlock before setup err = %v, want ErrNotConfigured", err)
+	}
+	if err := l.Setup("short"); !errors.Is(err, ErrPassphraseTooShort) {
+		t.Fatalf("Setup(short) err = %v, want ErrPassphraseTooShort", err)
+	}
+
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	if s := l.Status(); !s.Configured || s.Locked || s.IdleTimeoutSeconds != int(DefaultIdleTimeout/time.Second) {
+		t.Fatalf("status after setup = %+v", s)
+	}
+	if err := l.Require(); err != nil {
+		t.Fatalf("Require after setup: %v", err)
+	}
+	if err := l.Setup("another passphrase"); err == nil {
+		t.Fatalf("second Setup succeeded")
+	}
+
+	// 패스프레이즈는 저장되지 않고, 다시 열면 잠긴 상태로 시작합니다.
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte(testPassphrase)) {
+		t.Fatalf("lock file contains the passphrase")
+	}
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if s := reopened.Status(); !s.Configured || !s.Locked {
+		t.Fatalf("reopened status = %+v, want configured and locked", s)
+	}
+	if err := reopened.Unlock(testPassphrase); err != nil {
+		t.Fatalf("Unlock after reopen: %v", err)
+	}
+}
+
+func TestUnlockWrongPassphrase(t *testing.T) {
+	l, _ := newTestLock(t)
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	l.LockNow()
+
+	if err := l.Unlock("wrong passphrase"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Fatalf("Unlock(wrong) err = %v, want ErrWrongPassphrase", err)
+	}
+	if err := l.Require(); !errors.Is(err, ErrLocked) {
+		t.Fatalf("Require after a wrong passphrase = %v, want ErrLocked", err)
+	}
+	if _, err := l.Key(); !errors.Is(err, ErrLocked) {
+		t.Fatalf("Key after a wrong passphrase err = %v, want ErrLocked", err)
+	}
+	if err := l.Unlock(testPassphrase); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+}
+
+func TestIdleAutoLock(t *testing.T) {
+	l, _ := newTestLock(t)
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	if err := l.SetIdleTimeout(time.Minute); err != nil {
+		t.Fatalf("SetIdleTimeout: %v", err)
+	}
+
+	start := time.Now()
+	l.Touch()
+	l.lockIfIdle(start.Add(30 * time.Second))
+	if l.Status().Locked {
+		t.Fatalf("locked before the idle timeout")
+	}
+	l.lockIfIdle(start.Add(2 * time.Minute))
+	if !l.Status().Locked {
+		t.Fatalf("not locked after the idle timeout")
+	}
+
+	// 0 이면 자동으로 잠그지 않습니다.
+	if err := l.Unlock(testPassphrase); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	if err := l.SetIdleTimeout(0); err != nil {
+		t.Fatalf("SetIdleTimeout(0): %v", err)
+	}
+	l.lockIfIdle(time.Now().Add(24 * time.Hour))
+	if l.Status().Locked {
+		t.Fatalf("locked with auto-lock disabled")
+	}
+}
+
+func TestRunLocksWhenIdle(t *testing.T) {
+	saved := checkInterval
+	checkInterval = 10 * time.Millisecond
+	defer func() { checkInterval = saved }()
+
+	l, _ := newTestLock(t)
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	if err := l.SetIdleTimeout(time.Second); err != nil {
+		t.Fatalf("SetIdleTimeout: %v", err)
+	}
+	locked := make(chan Status, 4)
+	l.OnChange(func(s Status) {
+		if s.Locked {
+			locked <- s
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		l.Run(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	select {
+	case <-locked:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("Run did not lock after the idle timeout")
+	}
+	if err := l.Require(); !errors.Is(err, ErrLocked) {
+		t.Fatalf("Require after auto-lock = %v, want ErrLocked", err)
+	}
+}
+
+func TestChangePassphrase(t *testing.T) {
+	l, path := newTestLock(t)
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	var rekeyed int
+	l.OnRekey(func(oldKey, newKey []byte) error {
+		rekeyed++
+		return nil
+	})
+
+	if err := l.ChangePassphrase("wrong passphrase", "new passphrase"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Fatalf("ChangePassphrase(wrong) err = %v, want ErrWrongPassphrase", err)
+	}
+	if err := l.ChangePassphrase(testPassphrase, "new passphrase"); err != nil {
+		t.Fatalf("ChangePassphrase: %v", err)
+	}
+	if rekeyed != 1 {
+		t.Fatalf("rekey called %d times, want 1", rekeyed)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if err := reopened.Unlock(testPassphrase); !errors.Is(err, ErrWrongPassphrase) {
+		t.Fatalf("old passphrase after change err = %v, want ErrWrongPassphrase", err)
+	}
+	if err := reopened.Unlock("new passphrase"); err != nil {
+		t.Fatalf("new passphrase after change: %v", err)
+	}
+}
+
+// 다시 암호화가 실패하면 패스프레이즈와 데이터 모두 그대로여야 합니다.
+func TestChangePassphraseRekeyFails(t *testing.T) {
+	l, path := newTestLock(t)
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	oldKey, _ := l.Key()
+	l.OnRekey(func(oldKey, newKey []byte) error {
+		return errors.New("disk full")
+	})
+
+	if err := l.ChangePassphrase(testPassphrase, "new passphrase"); err == nil {
+		t.Fatalf("ChangePassphrase succeeded although rekey failed")
+	}
+	if key, _ := l.Key(); !bytes.Equal(key, oldKey) {
+		t.Fatalf("key changed after a failed rekey")
+	}
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if err := reopened.Unlock(testPassphrase); err != nil {
+		t.Fatalf("old passphrase after failed change: %v", err)
+	}
+}
+
+// 데이터를 새 키로 옮긴 뒤 잠금 파일 저장이 실패하면 데이터를 이전 키로 되돌려야 합니다.
+func TestChangePassphraseRollsBackRekey(t *testing.T) {
+	l, path := newTestLock(t)
+	if err := l.Setup(testPassphrase); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	oldKey, _ := l.Key()
+
+	var (
+		mu    sync.Mutex
+		calls [][2][]byte
+	)
+	l.OnRekey(func(from, to []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, [2][]byte{append([]byte(nil), from...), append([]byte(nil), to...)})
+		return nil
+	})
+
+	// 잠금 파일 자리를 비어 있지 않은 디렉터리로 바꿔 저장을 실패시킵니다.
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(path, "keep"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.ChangePassphrase(testPassphrase, "new passphrase"); err == nil {
+		t.Fatalf("ChangePassphrase succeeded although the lock file could not be written")
+	}
+	if len(calls) != 2 {
+		t.Fatalf("rekey called %d times, want forward and back", len(calls))
+	}
+	if !bytes.Equal(calls[0][0], oldKey) || !bytes.Equal(calls[1][1], oldKey) || !bytes.Equal(calls[0][1], calls[1][0]) {
+		t.Fatalf("rekey calls do not move the data to the new key and back again")
+	}
+	if key, _ := l.Key(); !bytes.Equal(key, oldKey) {
+		t.Fatalf("key changed after a failed change")
+	}
+	l.LockNow()
+	if err := l.Unlock(testPassphrase); err != nil {
+		t.Fatalf("old passphrase after rollback: %v", err)
+	}
+}
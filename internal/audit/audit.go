@@ -0,0 +1,343 @@
+// internal/audit/audit.go
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 결과 값
+const (
+	OutcomeOK    = "ok"
+	OutcomeError = "error"
+)
+
+// 한 줄 JSONL 의 최대 크기 (스캐너 버퍼)
+const maxLineSize = 1 << 20
+
+// Entry 는 감사 로그 한 줄입니다. Hash 는 Hash 를 비운 항목의 JSON 과 PrevHash 로 계산되므로,
+// 중간 줄을 고치거나 지우면 Verify 에서 사슬이 끊어진 위치가 드러납니다.
+type Entry struct {
+	Seq       int64             `json:"seq"`
+	Time      time.Time         `json:"time"`
+	User      string            `json:"user"`
+	Operation string            `json:"operation"`
+	Target    string            `json:"target,omitempty"`
+	Outcome   string            `json:"outcome"`
+	Error     string            `json:"error,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	PrevHash  string            `json:"prev_hash"`
+	Hash      string            `json:"hash"`
+}
+
+// Query 는 감사 로그 조회 조건입니다. 비어 있는 조건은 무시합니다.
+type Query struct {
+	// Operation 은 접두사로 비교합니다. (예: "profile." 은 모든 프로필 작업)
+	Operation string    `json:"operation"`
+	Target    string    `json:"target"`
+	Outcome   string    `json:"outcome"`
+	Since     time.Time `json:"since"`
+	Until     time.Time `json:"until"`
+	// Limit 이 0 보다 크면 조건에 맞는 가장 최근 항목을 최대 Limit 개 반환합니다.
+	Limit int `json:"limit"`
+}
+
+// Log 는 추가만 가능한 해시 사슬 JSONL 감사 로그입니다. nil *Log 의 Record 는 아무것도 하지 않으므로
+// 감사 로그를 쓰지 않는 곳(CLI 등)에서도 그대로 호출할 수 있습니다.
+//
+// GUI 와 CLI 가 같은 파일에 쓰므로 Record 는 파일 잠금을 잡은 뒤 마지막 항목을 다시 읽고 이어 씁니다.
+type Log struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	user     string
+	seq      int64
+	lastHash string
+	// size 는 seq/lastHash 를 읽었을 때의 파일 크기입니다. 다르면 다른 프로세스가 기록한 것입니다.
+	size int64
+}
+
+// Open 은 path 의 감사 로그를 열고 마지막 항목에서 사슬을 이어 갑니다.
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	l := &Log{path: path, file: file, user: currentUser(), size: -1}
+
+	if err := lockFile(file); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to lock audit log: %w", err)
+	}
+	err = l.syncTail()
+	unlockFile(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// Path 는 감사 로그 파일 경로를 반환합니다.
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Close 는 감사 로그 파일을 닫습니다.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Record 는 작업 하나를 기록합니다. err 가 nil 이 아니면 실패로 기록됩니다. params 는 Redact 로 정리됩니다.
+// 기록 실패는 원래 작업을 막지 않도록 반환하지 않고 표준 오류로만 알립니다.
+func (l *Log) Record(operation, target string, params map[string]interface{}, err error) {
+	if l == nil {
+		return
+	}
+
+	entry := Entry{
+		Time:      time.Now().UTC(),
+		User:      l.user,
+		Operation: operation,
+		Target:    target,
+		Outcome:   OutcomeOK,
+		Params:    Redact(params),
+	}
+	if err != nil {
+		entry.Outcome = OutcomeError
+		entry.Error = err.Error()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 다른 프로세스(CLI 등)와 사슬이 갈라지지 않도록 잠금 안에서 마지막 항목을 확인하고 씁니다.
+	if lerr := lockFile(l.file); lerr != nil {
+		fmt.Fprintf(os.Stderr, "audit: failed to lock log: %v\n", lerr)
+		return
+	}
+	defer unlockFile(l.file)
+	if serr := l.syncTail(); serr != nil {
+		fmt.Fprintf(os.Stderr, "audit: %v\n", serr)
+		return
+	}
+
+	entry.Seq = l.seq + 1
+	entry.PrevHash = l.lastHash
+	entry.Hash = hashEntry(entry)
+
+	line, merr := json.Marshal(entry)
+	if merr != nil {
+		fmt.Fprintf(os.Stderr, "audit: failed to encode entry: %v\n", merr)
+		return
+	}
+	if _, werr := l.file.Write(append(line, '\n')); werr != nil {
+		fmt.Fprintf(os.Stderr, "audit: failed to write entry: %v\n", werr)
+		return
+	}
+	l.seq = entry.Seq
+	l.lastHash = entry.Hash
+	l.size += int64(len(line)) + 1
+}
+
+// syncTail 은 파일 크기가 마지막으로 읽은 때와 다르면 마지막 항목을 다시 읽어 seq/lastHash 를 맞춥니다.
+// 파일 잠금을 잡은 상태에서 호출해야 합니다.
+func (l *Log) syncTail() error {
+	info, err := l.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat audit log: %w", err)
+	}
+	if info.Size() == l.size {
+		return nil
+	}
+	last, ok, err := readLastEntry(l.path, info.Size())
+	if err != nil {
+		return err
+	}
+	l.seq, l.lastHash = 0, ""
+	if ok {
+		l.seq, l.lastHash = last.Seq, last.Hash
+	}
+	l.size = info.Size()
+	return nil
+}
+
+// readLastEntry 는 파일 끝에서부터 읽어 마지막 항목을 반환합니다. 항목이 없으면 ok 가 false 입니다.
+func readLastEntry(path string, size int64) (e Entry, ok bool, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	defer file.Close()
+
+	const chunk = 4096
+	var tail []byte
+	for off := size; off > 0 && len(tail) <= maxLineSize; {
+		n := int64(chunk)
+		if n > off {
+			n = off
+		}
+		off -= n
+		buf := make([]byte, n)
+		if _, err := file.ReadAt(buf, off); err != nil {
+			return Entry{}, false, fmt.Errorf("failed to read audit log: %w", err)
+		}
+		tail = append(buf, tail...)
+
+		trimmed := bytes.TrimRight(tail, "\r\n\t ")
+		i := bytes.LastIndexByte(trimmed, '\n')
+		if i < 0 && off > 0 {
+			continue
+		}
+		line := trimmed[i+1:]
+		if len(line) == 0 {
+			return Entry{}, false, nil
+		}
+		if err := json.Unmarshal(line, &e); err != nil {
+			return Entry{}, false, fmt.Errorf("audit log last line is corrupt: %w", err)
+		}
+		return e, true, nil
+	}
+	if len(tail) == 0 {
+		return Entry{}, false, nil
+	}
+	return Entry{}, false, fmt.Errorf("audit log last line exceeds %d bytes", maxLineSize)
+}
+
+// Query 는 조건에 맞는 항목을 시간 순으로 반환합니다.
+func (l *Log) Query(q Query) ([]Entry, error) {
+	var entries []Entry
+	err := l.scan(func(e Entry) error {
+		if q.matches(e) {
+			entries = append(entries, e)
+			if q.Limit > 0 && len(entries) > q.Limit {
+				entries = entries[1:]
+			}
+		}
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Export 는 조건에 맞는 항목을 원본 JSONL 형식 그대로 w 에 씁니다. 내보낸 파일도 Verify 로 검증할 수 있도록
+// 조건 없이 내보내는 것을 권장합니다.
+func (l *Log) Export(w io.Writer, q Query) (int, error) {
+	entries, err := l.Query(q)
+	if err != nil {
+		return 0, err
+	}
+	enc := json.NewEncoder(w)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			return 0, err
+		}
+	}
+	return len(entries), nil
+}
+
+// Verify 는 해시 사슬을 처음부터 검사하고, 변조되거나 빠진 첫 항목을 오류로 알려 줍니다.
+func (l *Log) Verify() (int, error) {
+	var (
+		count    int
+		prevSeq  int64
+		prevHash string
+	)
+	err := l.scan(func(e Entry) error {
+		switch {
+		case e.Seq != prevSeq+1:
+			return fmt.Errorf("audit log entry %d: expected sequence %d (entries missing)", e.Seq, prevSeq+1)
+		case e.PrevHash != prevHash:
+			return fmt.Errorf("audit log entry %d: chain broken (previous hash does not match)", e.Seq)
+		case hashEntry(e) != e.Hash:
+			return fmt.Errorf("audit log entry %d: content was modified", e.Seq)
+		}
+		prevSeq, prevHash = e.Seq, e.Hash
+		count++
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// scan 은 파일의 항목을 순서대로 fn 에 넘깁니다.
+func (l *Log) scan(fn func(Entry) error) error {
+	file, err := os.Open(l.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return fmt.Errorf("audit log line %d is corrupt: %w", line, err)
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func (q Query) matches(e Entry) bool {
+	switch {
+	case q.Operation != "" && !strings.HasPrefix(e.Operation, q.Operation):
+		return false
+	case q.Target != "" && e.Target != q.Target:
+		return false
+	case q.Outcome != "" && e.Outcome != q.Outcome:
+		return false
+	case !q.Since.IsZero() && e.Time.Before(q.Since):
+		return false
+	case !q.Until.IsZero() && e.Time.After(q.Until):
+		return false
+	}
+	return true
+}
+
+func hashEntry(e Entry) string {
+	e.Hash = ""
+	data, _ := json.Marshal(e)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	for _, key := range []string{"USER", "USERNAME"} {
+		if name := os.Getenv(key); name != "" {
+			return name
+		}
+	}
+	return "unknown"
+}
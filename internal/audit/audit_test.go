@@ -0,0 +1,132 @@
+// internal/audit/audit_test.go
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// GUI 와 CLI 처럼 같은 파일을 연 두 Log 가 번갈아 기록해도 사슬이 이어져야 합니다.
+func TestRecordContinuesChainAcrossHandles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	gui, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer gui.Close()
+	gui.Record("profile.start", "p1", nil, nil)
+
+	cli, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer cli.Close()
+
+	// 두 핸들 모두 상대가 기록한 뒤에 기록합니다.
+	cli.Record("account.delete", "a@example.com", nil, nil)
+	gui.Record("profile.stop", "p1", nil, fmt.Errorf("boom"))
+	cli.Record("account.create", "b@example.com", map[string]interface{}{"password": "hunter2"}, nil)
+
+	count, err := gui.Verify()
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if count != 4 {
+		t.Fatalf("Verify count = %d, want 4", count)
+	}
+}
+
+func TestRecordConcurrentHandles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	const handles, perHandle = 3, 20
+
+	var wg sync.WaitGroup
+	for i := 0; i < handles; i++ {
+		l, err := Open(path)
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		defer l.Close()
+		wg.Add(1)
+		go func(i int, l *Log) {
+			defer wg.Done()
+			for j := 0; j < perHandle; j++ {
+				l.Record("profile.update", fmt.Sprintf("p%d-%d", i, j), nil, nil)
+			}
+		}(i, l)
+	}
+	wg.Wait()
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer l.Close()
+	count, err := l.Verify()
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if count != handles*perHandle {
+		t.Fatalf("Verify count = %d, want %d", count, handles*perHandle)
+	}
+}
+
+func TestVerifyDetectsModification(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer l.Close()
+	l.Record("profile.start", "p1", nil, nil)
+	l.Record("profile.stop", "p1", nil, nil)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := []byte(string(data[:len(data)/2]) + "x" + string(data[len(data)/2+1:]))
+	if err := os.WriteFile(path, tampered, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Verify(); err == nil {
+		t.Fatalf("Verify accepted a modified log")
+	}
+}
+
+func TestReadLastEntry(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		content string
+		seq     int64
+		ok      bool
+	}{
+		{"empty", "", 0, false},
+		{"blank lines", "\n\n", 0, false},
+		{"one entry", `{"seq":1,"hash":"a"}` + "\n", 1, true},
+		{"trailing blank line", `{"seq":1,"hash":"a"}` + "\n" + `{"seq":2,"hash":"b"}` + "\n\n", 2, true},
+		{"no trailing newline", `{"seq":1,"hash":"a"}` + "\n" + `{"seq":2,"hash":"b"}`, 2, true},
+		{"entry longer than a chunk", `{"seq":1,"hash":"a"}` + "\n" + `{"seq":2,"hash":"` + strings.Repeat("b", 10000) + `"}` + "\n", 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name+".jsonl")
+			if err := os.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			e, ok, err := readLastEntry(path, int64(len(tt.content)))
+			if err != nil {
+				t.Fatalf("readLastEntry: %v", err)
+			}
+			if ok != tt.ok || e.Seq != tt.seq {
+				t.Fatalf("readLastEntry = (seq %d, %v), want (seq %d, %v)", e.Seq, ok, tt.seq, tt.ok)
+			}
+		})
+	}
+}
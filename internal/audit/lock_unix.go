@@ -0,0 +1,19 @@
+// internal/audit/lock_unix.go
+
+//go:build !windows
+
+package audit
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile 은 다른 프로세스가 기록을 마칠 때까지 기다린 뒤 배타적 잠금을 잡습니다.
+func lockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+}
+
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,23 @@
+// internal/audit/lock_windows.go
+
+package audit
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// 잠금 범위를 파일 내용 밖에 두어 잠금 중에도 다른 프로세스가 로그를 읽을 수 있도록 합니다.
+const lockOffsetHigh = 0x7FFFFFFF
+
+// lockFile 은 다른 프로세스가 기록을 마칠 때까지 기다린 뒤 배타적 잠금을 잡습니다.
+func lockFile(f *os.File) error {
+	ol := &windows.Overlapped{OffsetHigh: lockOffsetHigh}
+	return windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK, 0, 1, 0, ol)
+}
+
+func unlockFile(f *os.File) error {
+	ol := &windows.Overlapped{OffsetHigh: lockOffsetHigh}
+	return windows.UnlockFileEx(windows.Handle(f.Fd()), 0, 1, 0, ol)
+}
@@ -0,0 +1,70 @@
+// internal/audit/redact.go
+
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// 값 요약의 최대 길이
+const maxValueLength = 64
+
+// 이름에 이 단어가 들어간 파라미터는 값을 남기지 않습니다.
+var sensitiveKeys = []string{"password", "passphrase", "secret", "token", "proxy", "cookie", "key", "credential"}
+
+// Redact 는 파라미터를 감사 로그에 남길 수 있는 요약으로 바꿉니다. 민감한 이름의 값은 가리고,
+// 목록은 개수만, 긴 문자열은 앞부분만 남깁니다.
+func Redact(params map[string]interface{}) map[string]string {
+	if len(params) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(params))
+	for k, v := range params {
+		out[k] = redactValue(k, v)
+	}
+	return out
+}
+
+func redactValue(key string, v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if SensitiveKey(key) {
+		if isEmpty(v) {
+			return ""
+		}
+		return "[redacted]"
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return fmt.Sprintf("[%d items]", rv.Len())
+	case reflect.Struct, reflect.Ptr:
+		return fmt.Sprintf("[%s]", rv.Type())
+	}
+
+	s := []rune(fmt.Sprint(v))
+	if len(s) > maxValueLength {
+		return string(s[:maxValueLength]) + "..."
+	}
+	return string(s)
+}
+
+// SensitiveKey 는 이름으로 보아 값을 남기면 안 되는 항목인지 반환합니다. 진단 번들의 설정 정리에도 사용합니다.
+func SensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmpty(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return !rv.IsValid() || rv.IsZero() || ((rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.Len() == 0)
+}
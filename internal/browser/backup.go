@@ -0,0 +1,279 @@
+// internal/browser/backup.go
+
+package browser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+// BundleVersion 은 현재 프로필 백업 파일 형식의 버전입니다.
+const BundleVersion = 1
+
+// 가져오기 시 같은 이름의 프로필이 이미 있을 때의 처리 방식
+const (
+	ConflictSkip      = "skip"
+	ConflictRename    = "rename"
+	ConflictOverwrite = "overwrite"
+)
+
+// ProfileBundle 은 프로필 설정을 옮기기 위한 백업 파일 형식입니다.
+type ProfileBundle struct {
+	Version    int              `json:"version"`
+	ExportedAt time.Time        `json:"exported_at"`
+	Encryption *utils.KDFParams `json:"encryption,omitempty"`
+	Profiles   []BundledProfile `json:"profiles"`
+}
+
+// BundledProfile 은 백업 파일에 담기는 프로필 하나입니다. 프록시와 계정(사용자 이름, 비밀번호)처럼
+// 비밀 정보가 담긴 필드는 패스프레이즈가 주어지면 Secrets 에 암호화되어 저장되고 평문 필드는 비워집니다.
+type BundledProfile struct {
+	SourceID    string    `json:"source_id"`
+	Name        string    `json:"name"`
+	Folder      string    `json:"folder"`
+	Group       string    `json:"group,omitempty"`
+	Tags        []string  `json:"tags"`
+	Notes       string    `json:"notes"`
+	OS          string    `json:"os"`
+	Browser     string    `json:"browser"`
+	CPU         int       `json:"cpu"`
+	Memory      int       `json:"memory"`
+	Screen      string    `json:"screen"`
+	Language    string    `json:"language"`
+	Geolocation string    `json:"geolocation,omitempty"`
+	Timezone    string    `json:"timezone,omitempty"`
+	Type        string    `json:"type"`
+	ConfigID    string    `json:"configid"`
+	Proxy       string    `json:"proxy,omitempty"`
+	Accounts    []Account `json:"accounts,omitempty"`
+	Secrets     []byte    `json:"secrets,omitempty"`
+}
+
+// bundleSecrets 는 암호화 대상 필드입니다.
+type bundleSecrets struct {
+	Proxy    string    `json:"proxy"`
+	Accounts []Account `json:"accounts,omitempty"`
+}
+
+// ImportResult 는 프로필 하나의 가져오기 결과입니다.
+type ImportResult struct {
+	Name      string `json:"name"`
+	ProfileID string `json:"profile_id,omitempty"`
+	Action    string `json:"action"` // created, renamed, overwritten, skipped, failed
+	Error     string `json:"error,omitempty"`
+}
+
+// ExportProfiles 는 선택한 프로필의 설정을 백업 파일 형식(JSON)으로 만듭니다.
+// passphrase 가 비어 있지 않으면 프록시와 계정 등 비밀 정보를 암호화합니다.
+func (bm *BrowserManager) ExportProfiles(profileIDs []string, passphrase string) ([]byte, error) {
+	bm.logger.Info("Exporting profiles", zap.Int("count", len(profileIDs)), zap.Bool("encrypted", passphrase != ""))
+
+	bundle := ProfileBundle{Version: BundleVersion, ExportedAt: time.Now().UTC()}
+
+	var key []byte
+	if passphrase != "" {
+		params, err := utils.NewKDFParams()
+		if err != nil {
+			return nil, err
+		}
+		bundle.Encryption = &params
+		key = params.DeriveKey(passphrase)
+	}
+
+	for _, id := range profileIDs {
+		info, err := bm.FetchProfileInfo(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch profile %s: %w", id, err)
+		}
+
+		p := info.Data
+		bp := BundledProfile{
+			SourceID:    id,
+			Name:        p.Name,
+			Folder:      p.Folder,
+			Group:       p.Group,
+			Tags:        p.Tags,
+			Notes:       p.Notes,
+			OS:          p.OS,
+			Browser:     p.Browser,
+			CPU:         p.CPU,
+			Memory:      p.Memory,
+			Screen:      p.Screen,
+			Language:    p.Language,
+			Geolocation: p.Geolocation,
+			Timezone:    p.Timezone,
+			Type:        p.Type,
+			ConfigID:    p.ConfigID,
+		}
+
+		if key != nil {
+			secrets, err := json.Marshal(bundleSecrets{Proxy: p.Proxy, Accounts: p.Accounts})
+			if err != nil {
+				return nil, err
+			}
+			if bp.Secrets, err = utils.Seal(key, secrets); err != nil {
+				return nil, fmt.Errorf("failed to encrypt profile %s: %w", id, err)
+			}
+		} else {
+			bp.Proxy = p.Proxy
+			bp.Accounts = p.Accounts
+		}
+
+		bundle.Profiles = append(bundle.Profiles, bp)
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	bm.logger.Info("Successfully exported profiles", zap.Int("count", len(bundle.Profiles)))
+	return data, nil
+}
+
+// ImportProfiles 는 백업 파일의 프로필을 만듭니다. 같은 이름의 프로필이 있으면 conflict
+// (skip, rename, overwrite) 에 따라 처리하고, 프로필별 결과를 반환합니다.
+// 패스프레이즈가 틀리면 프로필을 하나도 만들지 않고 utils.ErrDecrypt 를 반환합니다.
+func (bm *BrowserManager) ImportProfiles(data []byte, passphrase string, conflict string) ([]ImportResult, error) {
+	switch conflict {
+	case ConflictSkip, ConflictRename, ConflictOverwrite:
+	default:
+		return nil, fmt.Errorf("unknown conflict mode %q", conflict)
+	}
+
+	var bundle ProfileBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse profile bundle: %w", err)
+	}
+	if bundle.Version < 1 || bundle.Version > BundleVersion {
+		return nil, fmt.Errorf("unsupported profile bundle version %d", bundle.Version)
+	}
+
+	var key []byte
+	if bundle.Encryption != nil {
+		if passphrase == "" {
+			return nil, fmt.Errorf("profile bundle is encrypted, passphrase required")
+		}
+		key = bundle.Encryption.DeriveKey(passphrase)
+	}
+
+	// 만들기 전에 모두 복호화해, 패스프레이즈가 틀렸을 때 프로필마다 실패하지 않고 바로 중단합니다.
+	reqs := make([]CreateProfileRequest, len(bundle.Profiles))
+	reqErrs := make([]error, len(bundle.Profiles))
+	for i, bp := range bundle.Profiles {
+		reqs[i], reqErrs[i] = bp.toRequest(key)
+		if errors.Is(reqErrs[i], utils.ErrDecrypt) {
+			return nil, fmt.Errorf("failed to decrypt profile bundle: %w", reqErrs[i])
+		}
+	}
+
+	existing, err := bm.FetchProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch existing profiles: %w", err)
+	}
+	byName := make(map[string]string, len(existing.Data))
+	for id, p := range existing.Data {
+		byName[p.Name] = id
+	}
+
+	bm.logger.Info("Importing profiles", zap.Int("count", len(bundle.Profiles)), zap.String("conflict", conflict))
+
+	results := make([]ImportResult, 0, len(bundle.Profiles))
+	for i, bp := range bundle.Profiles {
+		req := reqs[i]
+		if err := reqErrs[i]; err != nil {
+			results = append(results, ImportResult{Name: bp.Name, Action: "failed", Error: err.Error()})
+			continue
+		}
+
+		result := ImportResult{Name: req.Name, Action: "created"}
+		if existingID, ok := byName[req.Name]; ok {
+			switch conflict {
+			case ConflictSkip:
+				result.Action = "skipped"
+				result.ProfileID = existingID
+				results = append(results, result)
+				continue
+			case ConflictOverwrite:
+				result.ProfileID = existingID
+				if _, err := bm.ModifyProfile(existingID, req); err != nil {
+					result.Action, result.Error = "failed", err.Error()
+				} else {
+					result.Action = "overwritten"
+				}
+				results = append(results, result)
+				continue
+			case ConflictRename:
+				req.Name = uniqueName(req.Name, byName)
+				result.Name, result.Action = req.Name, "renamed"
+			}
+		}
+
+		resp, err := bm.AddProfile(req)
+		if err != nil {
+			result.Action, result.Error = "failed", err.Error()
+		} else {
+			result.ProfileID, _ = resp["profile_id"].(string)
+			byName[req.Name] = result.ProfileID
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// toRequest 는 백업된 프로필을 생성 요청으로 변환합니다.
+func (bp BundledProfile) toRequest(key []byte) (CreateProfileRequest, error) {
+	req := CreateProfileRequest{
+		Name:        bp.Name,
+		OS:          bp.OS,
+		Browser:     bp.Browser,
+		CPU:         bp.CPU,
+		Memory:      bp.Memory,
+		Tags:        bp.Tags,
+		Geolocation: bp.Geolocation,
+		Resolution:  bp.Screen,
+		Proxy:       bp.Proxy,
+		Notes:       bp.Notes,
+		Folder:      bp.Folder,
+		Language:    bp.Language,
+		Accounts:    bp.Accounts,
+		Type:        bp.Type,
+		Group:       bp.Group,
+		ConfigID:    bp.ConfigID,
+		Timezone:    bp.Timezone,
+	}
+
+	if len(bp.Secrets) > 0 {
+		if key == nil {
+			return req, fmt.Errorf("profile %s has encrypted fields but the bundle has no encryption header", bp.Name)
+		}
+		plaintext, err := utils.Open(key, bp.Secrets)
+		if err != nil {
+			return req, err
+		}
+		var secrets bundleSecrets
+		if err := json.Unmarshal(plaintext, &secrets); err != nil {
+			return req, fmt.Errorf("failed to decode encrypted fields: %w", err)
+		}
+		req.Proxy = secrets.Proxy
+		req.Accounts = secrets.Accounts
+	}
+
+	return req, nil
+}
+
+// uniqueName 은 taken 에 없는 "name (n)" 형태의 이름을 찾습니다.
+func uniqueName(name string, taken map[string]string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s (%d)", name, n)
+		if _, ok := taken[candidate]; !ok {
+			return candidate
+		}
+	}
+}
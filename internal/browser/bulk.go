@@ -0,0 +1,128 @@
+// internal/browser/bulk.go
+
+package browser
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// 일괄 작업 종류
+const (
+	BulkLaunch = "launch"
+	BulkStop   = "stop"
+	BulkRemove = "remove"
+)
+
+// DefaultBulkConcurrency 는 동시 실행 수를 지정하지 않았을 때의 기본값입니다.
+const DefaultBulkConcurrency = 4
+
+// BulkOptions 는 일괄 작업의 동시 실행 수와 진행 콜백입니다.
+type BulkOptions struct {
+	Concurrency int
+	// OnProgress 는 항목 하나가 끝날 때마다 호출됩니다. 호출은 한 번에 하나씩 이루어지고 Done 은
+	// 1 부터 순서대로 증가하므로, 콜백은 짧게 끝나야 합니다.
+	OnProgress func(BulkProgress)
+}
+
+// BulkResult 는 일괄 작업에서 프로필 하나의 결과입니다.
+type BulkResult struct {
+	ProfileID string                 `json:"profile_id"`
+	OK        bool                   `json:"ok"`
+	Error     string                 `json:"error,omitempty"`
+	Response  map[string]interface{} `json:"response,omitempty"`
+}
+
+// BulkProgress 는 진행 이벤트 내용입니다.
+type BulkProgress struct {
+	Operation string     `json:"operation"`
+	Done      int        `json:"done"`
+	Total     int        `json:"total"`
+	Result    BulkResult `json:"result"`
+}
+
+// LaunchProfiles 는 여러 프로필을 동시에 실행합니다.
+func (bm *BrowserManager) LaunchProfiles(ctx context.Context, profileIDs []string, opts BulkOptions) []BulkResult {
+	return bm.runBulk(ctx, BulkLaunch, profileIDs, opts, bm.launchProfile)
+}
+
+// StopProfiles 는 여러 프로필을 동시에 종료합니다.
+func (bm *BrowserManager) StopProfiles(ctx context.Context, profileIDs []string, opts BulkOptions) []BulkResult {
+	return bm.runBulk(ctx, BulkStop, profileIDs, opts, bm.stopProfile)
+}
+
+// RemoveProfiles 는 여러 프로필을 동시에 삭제합니다.
+func (bm *BrowserManager) RemoveProfiles(ctx context.Context, profileIDs []string, opts BulkOptions) []BulkResult {
+	return bm.runBulk(ctx, BulkRemove, profileIDs, opts, bm.removeProfile)
+}
+
+// runBulk 는 최대 opts.Concurrency 개씩 action 을 실행합니다. ctx 가 취소되면 아직 시작하지 않은
+// 항목은 실행하지 않고 취소 오류로 기록합니다. 결과는 입력 순서와 같습니다.
+func (bm *BrowserManager) runBulk(ctx context.Context, op string, profileIDs []string, opts BulkOptions,
+	action func(context.Context, string) (map[string]interface{}, error)) []BulkResult {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	bm.logger.Info("Starting bulk profile operation",
+		zap.String("operation", op), zap.Int("count", len(profileIDs)), zap.Int("concurrency", concurrency))
+
+	results := make([]BulkResult, len(profileIDs))
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		done int
+	)
+	sem := make(chan struct{}, concurrency)
+
+	// 진행 이벤트가 Done 순서대로 나가도록 잠금을 쥔 채로 콜백을 호출합니다.
+	finish := func(i int, result BulkResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		results[i] = result
+		done++
+		if opts.OnProgress != nil {
+			opts.OnProgress(BulkProgress{Operation: op, Done: done, Total: len(profileIDs), Result: result})
+		}
+	}
+
+	for i, id := range profileIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			finish(i, BulkResult{ProfileID: id, Error: ctx.Err().Error()})
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				finish(i, BulkResult{ProfileID: id, Error: err.Error()})
+				return
+			}
+			resp, err := action(ctx, id)
+			if err != nil {
+				finish(i, BulkResult{ProfileID: id, Error: err.Error()})
+				return
+			}
+			finish(i, BulkResult{ProfileID: id, OK: true, Response: resp})
+		}(i, id)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, r := range results {
+		if !r.OK {
+			failed++
+		}
+	}
+	bm.logger.Info("Bulk profile operation finished",
+		zap.String("operation", op), zap.Int("count", len(profileIDs)), zap.Int("failed", failed))
+	return results
+}
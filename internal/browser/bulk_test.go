@@ -0,0 +1,105 @@
+// internal/browser/bulk_test.go
+
+package browser
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunBulkRespectsConcurrency(t *testing.T) {
+	bm := newTestManager(t, nil)
+	ids := []string{"p1", "p2", "p3", "p4", "p5", "p6", "p7", "p8"}
+
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxSeen  int
+		dones    []int
+	)
+	action := func(ctx context.Context, id string) (map[string]interface{}, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		if id == "p3" {
+			return nil, fmt.Errorf("boom")
+		}
+		return map[string]interface{}{"id": id}, nil
+	}
+	opts := BulkOptions{Concurrency: 3, OnProgress: func(p BulkProgress) {
+		// 진행 콜백은 직렬화되지만 mu 는 action 과 함께 쓰므로 잠급니다.
+		mu.Lock()
+		dones = append(dones, p.Done)
+		mu.Unlock()
+		if p.Total != len(ids) || p.Operation != BulkLaunch {
+			t.Errorf("progress = %+v", p)
+		}
+	}}
+
+	results := bm.runBulk(context.Background(), BulkLaunch, ids, opts, action)
+
+	if maxSeen > 3 || maxSeen < 2 {
+		t.Fatalf("max in flight = %d, want at most 3 and some parallelism", maxSeen)
+	}
+	for i, r := range results {
+		if r.ProfileID != ids[i] {
+			t.Fatalf("results[%d].ProfileID = %q, want %q", i, r.ProfileID, ids[i])
+		}
+		wantOK := ids[i] != "p3"
+		if r.OK != wantOK || (wantOK && r.Response["id"] != ids[i]) || (!wantOK && r.Error != "boom") {
+			t.Fatalf("results[%d] = %+v", i, r)
+		}
+	}
+	if len(dones) != len(ids) {
+		t.Fatalf("progress events = %v, want %d", dones, len(ids))
+	}
+	for i, d := range dones {
+		if d != i+1 {
+			t.Fatalf("progress Done values = %v, want 1..%d in order", dones, len(ids))
+		}
+	}
+}
+
+func TestRunBulkCancelMarksUnstarted(t *testing.T) {
+	bm := newTestManager(t, nil)
+	ids := []string{"p1", "p2", "p3", "p4", "p5"}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan string, len(ids))
+	action := func(ctx context.Context, id string) (map[string]interface{}, error) {
+		started <- id
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	var results []BulkResult
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		results = bm.runBulk(ctx, BulkStop, ids, BulkOptions{Concurrency: 2}, action)
+	}()
+	<-started
+	<-started
+	cancel()
+	<-finished
+
+	if len(started) != 0 {
+		t.Fatalf("%d more actions started after cancel", len(started))
+	}
+	for i, r := range results {
+		if r.ProfileID != ids[i] || r.OK || r.Error != context.Canceled.Error() {
+			t.Fatalf("results[%d] = %+v, want %s cancelled", i, r, ids[i])
+		}
+	}
+}
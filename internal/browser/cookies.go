@@ -0,0 +1,213 @@
+// internal/browser/cookies.go
+
+package browser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 쿠키 파일 형식
+const (
+	CookieFormatNetscape = "netscape"
+	CookieFormatJSON     = "json"
+)
+
+// Netscape cookies.txt 에서 HttpOnly 쿠키는 도메인 앞에 이 접두사가 붙습니다.
+const httpOnlyPrefix = "#HttpOnly_"
+
+var sameSiteValues = map[string]string{
+	"":               "",
+	"unspecified":    "unspecified",
+	"no_restriction": "no_restriction",
+	"none":           "no_restriction",
+	"lax":            "lax",
+	"strict":         "strict",
+}
+
+// ParseCookies 는 내용을 보고 JSON 배열 또는 Netscape 형식으로 쿠키를 읽습니다.
+func ParseCookies(data []byte) ([]Cookie, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return ParseJSONCookies(data)
+	}
+	return ParseNetscapeCookies(data)
+}
+
+// ParseJSONCookies 는 브라우저 확장에서 흔히 쓰는 JSON 배열 형식의 쿠키를 읽고 검증합니다.
+func ParseJSONCookies(data []byte) ([]Cookie, error) {
+	var cookies []Cookie
+	if err := json.Unmarshal(data, &cookies); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON cookies: %w", err)
+	}
+	return NormalizeCookies(cookies)
+}
+
+// ParseNetscapeCookies 는 Netscape cookies.txt 형식
+// (domain, include subdomains, path, secure, expiry, name, value 를 탭으로 구분) 을 읽고 검증합니다.
+func ParseNetscapeCookies(data []byte) ([]Cookie, error) {
+	var cookies []Cookie
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		httpOnly := false
+		if strings.HasPrefix(line, httpOnlyPrefix) {
+			httpOnly = true
+			line = strings.TrimPrefix(line, httpOnlyPrefix)
+		} else if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("line %d: expected 7 tab separated fields, got %d", lineNo, len(fields))
+		}
+
+		includeSubdomains, err := parseNetscapeBool(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: include subdomains: %w", lineNo, err)
+		}
+		secure, err := parseNetscapeBool(fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: secure: %w", lineNo, err)
+		}
+		expires, err := strconv.ParseFloat(fields[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid expiry %q", lineNo, fields[4])
+		}
+
+		cookies = append(cookies, Cookie{
+			Domain:         fields[0],
+			HostOnly:       !includeSubdomains,
+			Path:           fields[2],
+			Secure:         secure,
+			ExpirationDate: expires,
+			Session:        expires == 0,
+			Name:           fields[5],
+			Value:          fields[6],
+			HTTPOnly:       httpOnly,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cookies: %w", err)
+	}
+
+	return NormalizeCookies(cookies)
+}
+
+// FormatCookies 는 쿠키를 지정한 형식으로 직렬화합니다.
+func FormatCookies(cookies []Cookie, format string) ([]byte, error) {
+	switch format {
+	case CookieFormatJSON:
+		return json.MarshalIndent(cookies, "", "  ")
+	case CookieFormatNetscape:
+		return FormatNetscapeCookies(cookies)
+	default:
+		return nil, fmt.Errorf("unknown cookie format %q", format)
+	}
+}
+
+// FormatNetscapeCookies 는 쿠키를 Netscape cookies.txt 형식으로 직렬화합니다.
+// 이 형식에는 이스케이프가 없으므로 필드에 탭이나 줄바꿈이 있는 쿠키는 오류로 거부합니다.
+func FormatNetscapeCookies(cookies []Cookie) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("# Netscape HTTP Cookie File\n")
+	for i, c := range cookies {
+		if strings.ContainsAny(c.Domain+c.Path+c.Name+c.Value, "\t\r\n") {
+			return nil, fmt.Errorf("cookie #%d (%q): a tab or newline in its fields cannot be written to cookies.txt", i+1, c.Name)
+		}
+		domain := c.Domain
+		if c.HTTPOnly {
+			domain = httpOnlyPrefix + domain
+		}
+		expires := int64(c.ExpirationDate)
+		if c.Session {
+			expires = 0
+		}
+		fmt.Fprintf(&b, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			domain, formatNetscapeBool(!c.HostOnly), c.Path, formatNetscapeBool(c.Secure), expires, c.Name, c.Value)
+	}
+	return []byte(b.String()), nil
+}
+
+// NormalizeCookies 는 쿠키를 검증하고 기본값(path "/", sameSite 소문자 등)을 채웁니다.
+func NormalizeCookies(cookies []Cookie) ([]Cookie, error) {
+	if cookies == nil {
+		return nil, nil
+	}
+	out := make([]Cookie, 0, len(cookies))
+	for i, c := range cookies {
+		if err := normalizeCookie(&c); err != nil {
+			return nil, fmt.Errorf("cookie #%d (%s): %w", i+1, c.Name, err)
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func normalizeCookie(c *Cookie) error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if strings.ContainsAny(c.Name, " \t\r\n;,=") {
+		return fmt.Errorf("name contains invalid characters")
+	}
+	if strings.ContainsAny(c.Value, "\t\r\n;") {
+		return fmt.Errorf("value contains invalid characters")
+	}
+
+	c.Domain = strings.ToLower(strings.TrimSpace(c.Domain))
+	if c.Domain == "" || strings.ContainsAny(c.Domain, " \t/") {
+		return fmt.Errorf("invalid domain %q", c.Domain)
+	}
+	// ".example.com" 은 하위 도메인 포함 쿠키입니다.
+	if strings.HasPrefix(c.Domain, ".") {
+		c.HostOnly = false
+	}
+
+	if c.Path == "" {
+		c.Path = "/"
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		return fmt.Errorf("path must start with /")
+	}
+
+	if c.ExpirationDate < 0 {
+		return fmt.Errorf("expiration date must not be negative")
+	}
+	if c.ExpirationDate == 0 {
+		c.Session = true
+	}
+
+	sameSite, ok := sameSiteValues[strings.ToLower(c.SameSite)]
+	if !ok {
+		return fmt.Errorf("invalid sameSite %q", c.SameSite)
+	}
+	c.SameSite = sameSite
+	if sameSite == "no_restriction" && !c.Secure {
+		return fmt.Errorf("sameSite=None requires secure")
+	}
+	return nil
+}
+
+func parseNetscapeBool(s string) (bool, error) {
+	switch strings.ToUpper(s) {
+	case "TRUE":
+		return true, nil
+	case "FALSE":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected TRUE or FALSE, got %q", s)
+}
+
+func formatNetscapeBool(b bool) string {
+	if b {
+		return "TRUE"
+	}
+	return "FALSE"
+}
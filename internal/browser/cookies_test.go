@@ -0,0 +1,67 @@
+// internal/browser/cookies_test.go
+
+package browser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNetscapeCookiesRoundTrip(t *testing.T) {
+	cookies := []Cookie{
+		{Name: "sid", Value: "abc", Domain: ".example.com", Path: "/", Secure: true, ExpirationDate: 1893456000, HTTPOnly: true},
+		{Name: "pref", Value: "dark", Domain: "example.com", HostOnly: true, Path: "/app", Session: true},
+	}
+	data, err := FormatNetscapeCookies(cookies)
+	if err != nil {
+		t.Fatalf("FormatNetscapeCookies: %v", err)
+	}
+	parsed, err := ParseCookies(data)
+	if err != nil {
+		t.Fatalf("ParseCookies: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, cookies) {
+		t.Fatalf("round trip = %+v, want %+v", parsed, cookies)
+	}
+}
+
+func TestFormatNetscapeCookiesRejectsSeparators(t *testing.T) {
+	tests := []Cookie{
+		{Name: "sid", Value: "a\tb", Domain: ".example.com", Path: "/"},
+		{Name: "sid", Value: "a\nb", Domain: ".example.com", Path: "/"},
+		{Name: "s\rid", Value: "a", Domain: ".example.com", Path: "/"},
+		{Name: "sid", Value: "a", Domain: ".example.com", Path: "/\t"},
+	}
+	for _, c := range tests {
+		if data, err := FormatNetscapeCookies([]Cookie{c}); err == nil {
+			t.Errorf("FormatNetscapeCookies(%q=%q) = %q, want error", c.Name, c.Value, data)
+		}
+	}
+}
+
+func TestParseCookies(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		count   int
+		wantErr bool
+	}{
+		{"empty", "", 0, false},
+		{"comments only", "# Netscape HTTP Cookie File\n\n# comment\n", 0, false},
+		{"json", `[{"name":"sid","value":"1","domain":".example.com"}]`, 1, false},
+		{"netscape", ".example.com\tTRUE\t/\tFALSE\t0\tsid\t1\n", 1, false},
+		{"wrong field count", ".example.com\tTRUE\t/\tFALSE\t0\tsid\n", 0, true},
+		{"json tab in value", `[{"name":"sid","value":"a\tb","domain":".example.com"}]`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cookies, err := ParseCookies([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCookies err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(cookies) != tt.count {
+				t.Fatalf("ParseCookies returned %d cookies, want %d", len(cookies), tt.count)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// internal/browser/hidemyacc.go
+
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HideMyAccBaseURL 은 HideMyAcc 앱이 여는 로컬 API 주소입니다.
+const HideMyAccBaseURL = "http://127.0.0.1:2268"
+
+// hideMyAcc 는 HideMyAcc 로컬 API 공급자입니다. 응답은 {"code", "message", "data"} 형태이며
+// 프로필은 Undetectable 과 같은 Profile 형태로 바꿔 돌려줍니다.
+type hideMyAcc struct {
+	t       transport
+	baseURL string
+}
+
+func newHideMyAcc(t transport, baseURL string) *hideMyAcc {
+	return &hideMyAcc{t: t, baseURL: baseURL}
+}
+
+// hideMyAccResponse 는 HideMyAcc 로컬 API 의 공통 응답 형식입니다.
+type hideMyAccResponse struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type hideMyAccProfile struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	OS      string   `json:"os"`
+	Browser string   `json:"browser"`
+	Status  string   `json:"status"`
+	Notes   string   `json:"note"`
+	Tags    []string `json:"tags"`
+	Proxy   string   `json:"proxy"`
+}
+
+// hideMyAccSession 은 실행 응답의 연결 정보입니다.
+type hideMyAccSession struct {
+	Port  int    `json:"port"`
+	WSURL string `json:"wsUrl"`
+}
+
+func (h *hideMyAcc) Name() string { return ProviderHideMyAcc }
+
+func (h *hideMyAcc) Capabilities() Capabilities {
+	return Capabilities{Update: true, Tags: true, Debugger: true}
+}
+
+func (h *hideMyAcc) List(ctx context.Context) (*ProfileResponse, error) {
+	var profiles []hideMyAccProfile
+	if err := h.request(ctx, http.MethodGet, "/profiles", nil, &profiles); err != nil {
+		return nil, err
+	}
+	out := &ProfileResponse{Status: "success", Data: make(map[string]Profile, len(profiles))}
+	for _, p := range profiles {
+		out.Data[p.ID] = p.profile()
+	}
+	return out, nil
+}
+
+func (h *hideMyAcc) Info(ctx context.Context, profileID string) (*ProfileInfoResponse, error) {
+	var p hideMyAccProfile
+	if err := h.request(ctx, http.MethodGet, "/profiles/"+profileID, nil, &p); err != nil {
+		return nil, err
+	}
+	p.ID = profileID
+	return &ProfileInfoResponse{Status: "success", Data: p.profile()}, nil
+}
+
+func (h *hideMyAcc) Create(ctx context.Context, req CreateProfileRequest) (map[string]interface{}, error) {
+	var p hideMyAccProfile
+	if err := h.request(ctx, http.MethodPost, "/profiles", hideMyAccRequest(req), &p); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"status": "success", "profile_id": p.ID}, nil
+}
+
+func (h *hideMyAcc) Update(ctx context.Context, profileID string, body interface{}) (map[string]interface{}, error) {
+	if req, ok := body.(CreateProfileRequest); ok {
+		body = hideMyAccRequest(req)
+	}
+	if err := h.request(ctx, http.MethodPut, "/profiles/"+profileID, body, nil); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"status": "success", "profile_id": profileID}, nil
+}
+
+func (h *hideMyAcc) Delete(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	if err := h.request(ctx, http.MethodDelete, "/profiles/"+profileID, nil, nil); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"status": "success", "profile_id": profileID}, nil
+}
+
+func (h *hideMyAcc) Start(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	var s hideMyAccSession
+	if err := h.request(ctx, http.MethodPost, "/profiles/start/"+profileID, nil, &s); err != nil {
+		return nil, err
+	}
+	response := map[string]interface{}{"status": "success", "profile_id": profileID, "websocket_link": s.WSURL}
+	if s.Port > 0 {
+		response["debug_port"] = strconv.Itoa(s.Port)
+	}
+	return response, nil
+}
+
+func (h *hideMyAcc) Stop(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	if err := h.request(ctx, http.MethodPost, "/profiles/stop/"+profileID, nil, nil); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"status": "success", "profile_id": profileID}, nil
+}
+
+// Account 는 HideMyAcc 앱에 로그인한 계정 정보(/me)를 반환합니다.
+func (h *hideMyAcc) Account(ctx context.Context) (map[string]interface{}, error) {
+	var me map[string]interface{}
+	if err := h.request(ctx, http.MethodGet, "/me", nil, &me); err != nil {
+		return nil, err
+	}
+	return me, nil
+}
+
+// request 는 요청을 보내고 응답의 data 를 out 에 디코딩합니다. GET 만 재시도합니다.
+func (h *hideMyAcc) request(ctx context.Context, method, path string, body, out interface{}) error {
+	var (
+		resp *http.Response
+		err  error
+	)
+	if method == http.MethodGet {
+		resp, err = h.t.get(ctx, h.baseURL+path)
+	} else {
+		var data []byte
+		if body != nil {
+			if data, err = json.Marshal(body); err != nil {
+				return fmt.Errorf("failed to marshal hidemyacc request: %w", err)
+			}
+		}
+		resp, err = h.t.call(ctx, method, h.baseURL+path, data)
+	}
+	if err != nil {
+		return err
+	}
+
+	status := resp.StatusCode
+	var envelope hideMyAccResponse
+	if err := decodeJSON(resp, &envelope, "hidemyacc"); err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest {
+		if envelope.Message == "" {
+			envelope.Message = http.StatusText(status)
+		}
+		if status == http.StatusNotFound && strings.HasPrefix(path, "/profiles") {
+			return fmt.Errorf("%w: hidemyacc %s %s: %s", ErrProfileNotFound, method, path, envelope.Message)
+		}
+		return fmt.Errorf("hidemyacc %s %s: %s", method, path, envelope.Message)
+	}
+	if out == nil || len(envelope.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return fmt.Errorf("failed to decode hidemyacc %s response: %w", path, err)
+	}
+	return nil
+}
+
+// profile 은 Profile 형태로 바꿉니다. 실행 상태는 상태 감시가 알아보도록 Undetectable 과 같은 "Started" 로 맞춥니다.
+func (p hideMyAccProfile) profile() Profile {
+	status := p.Status
+	if strings.EqualFold(status, "running") {
+		status = "Started"
+	}
+	return Profile{
+		ID:      p.ID,
+		Name:    p.Name,
+		OS:      p.OS,
+		Browser: p.Browser,
+		Status:  status,
+		Notes:   p.Notes,
+		Tags:    p.Tags,
+		Proxy:   p.Proxy,
+	}
+}
+
+// hideMyAccRequest 는 HideMyAcc 가 받는 필드만 남깁니다. 쿠키, 폴더 등은 지원하지 않습니다.
+func hideMyAccRequest(req CreateProfileRequest) map[string]interface{} {
+	body := map[string]interface{}{"name": req.Name}
+	for key, value := range map[string]string{"os": req.OS, "browser": req.Browser, "note": req.Notes, "proxy": req.Proxy} {
+		if value != "" {
+			body[key] = value
+		}
+	}
+	if len(req.Tags) > 0 {
+		body["tags"] = req.Tags
+	}
+	return body
+}
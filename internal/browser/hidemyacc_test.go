@@ -0,0 +1,132 @@
+// internal/browser/hidemyacc_test.go
+
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// fakeHideMyAcc 는 HideMyAcc 로컬 API 의 {"code", "message", "data"} 응답을 흉내 냅니다.
+func fakeHideMyAcc(t *testing.T) (*httptest.Server, *requestLog) {
+	t.Helper()
+	log := &requestLog{}
+	reply := func(w http.ResponseWriter, status int, data interface{}) {
+		raw, _ := json.Marshal(data)
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(hideMyAccResponse{Code: status, Message: http.StatusText(status), Data: raw})
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/profiles", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		switch r.Method {
+		case http.MethodGet:
+			reply(w, http.StatusOK, []hideMyAccProfile{
+				{ID: "h1", Name: "one", Status: "running", Tags: []string{"x"}},
+				{ID: "h2", Name: "two", Status: "stopped"},
+			})
+		case http.MethodPost:
+			reply(w, http.StatusOK, hideMyAccProfile{ID: "h3"})
+		}
+	})
+	mux.HandleFunc("/profiles/", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		switch r.URL.Path {
+		case "/profiles/h1":
+			reply(w, http.StatusOK, hideMyAccProfile{Name: "one", Status: "running"})
+		case "/profiles/start/h1":
+			reply(w, http.StatusOK, hideMyAccSession{Port: 9333, WSURL: "ws://127.0.0.1:9333/devtools/browser/y"})
+		case "/profiles/stop/h1":
+			reply(w, http.StatusOK, nil)
+		default:
+			reply(w, http.StatusNotFound, nil)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, log
+}
+
+func TestHideMyAccProfileMapping(t *testing.T) {
+	tests := []struct {
+		in   hideMyAccProfile
+		want Profile
+	}{
+		{
+			hideMyAccProfile{ID: "h1", Name: "one", OS: "windows", Browser: "chrome", Status: "running", Notes: "n", Tags: []string{"a"}, Proxy: "http://p"},
+			Profile{ID: "h1", Name: "one", OS: "windows", Browser: "chrome", Status: "Started", Notes: "n", Tags: []string{"a"}, Proxy: "http://p"},
+		},
+		{hideMyAccProfile{ID: "h2", Status: "RUNNING"}, Profile{ID: "h2", Status: "Started"}},
+		{hideMyAccProfile{ID: "h3", Status: "stopped"}, Profile{ID: "h3", Status: "stopped"}},
+	}
+	for _, tt := range tests {
+		if got := tt.in.profile(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("profile(%+v) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHideMyAccEndpoints(t *testing.T) {
+	srv, log := fakeHideMyAcc(t)
+	bm := newTestManager(t, nil)
+	h := newHideMyAcc(bm.transport(ProviderHideMyAcc), srv.URL)
+	ctx := context.Background()
+
+	list, err := h.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list.Data) != 2 || list.Data["h1"].Status != "Started" || list.Data["h2"].Status != "stopped" {
+		t.Fatalf("List data = %+v", list.Data)
+	}
+
+	info, err := h.Info(ctx, "h1")
+	if err != nil {
+		t.Fatalf("Info: %v", err)
+	}
+	if info.Data.ID != "h1" || info.Data.Status != "Started" {
+		t.Fatalf("Info data = %+v", info.Data)
+	}
+	if _, err := h.Info(ctx, "missing"); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("Info(missing) err = %v, want ErrProfileNotFound", err)
+	}
+
+	created, err := h.Create(ctx, CreateProfileRequest{Name: "new", Folder: "ignored", Tags: []string{"t"}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created["profile_id"] != "h3" {
+		t.Fatalf("Create response = %v", created)
+	}
+	_, body := log.last()
+	var sent map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &sent); err != nil {
+		t.Fatalf("Create body %q: %v", body, err)
+	}
+	if _, ok := sent["folder"]; ok || sent["name"] != "new" {
+		t.Fatalf("Create body = %v, want name and no folder", sent)
+	}
+
+	started, err := h.Start(ctx, "h1")
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if req, _ := log.last(); req != "POST /profiles/start/h1" {
+		t.Fatalf("Start sent %q", req)
+	}
+	if started["debug_port"] != "9333" || started["websocket_link"] != "ws://127.0.0.1:9333/devtools/browser/y" {
+		t.Fatalf("Start response = %v", started)
+	}
+
+	if _, err := h.Stop(ctx, "h1"); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if req, _ := log.last(); req != "POST /profiles/stop/h1" {
+		t.Fatalf("Stop sent %q", req)
+	}
+}
@@ -0,0 +1,287 @@
+// internal/browser/organize.go
+
+package browser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// ProfileDiff 는 프로필 수정 시 변경할 필드만 담습니다. nil 인 필드는 전송되지 않습니다.
+type ProfileDiff struct {
+	Folder  *string   `json:"folder,omitempty"`
+	Tags    *[]string `json:"tags,omitempty"`
+	Cookies *[]Cookie `json:"cookies,omitempty"`
+}
+
+// IsEmpty 는 변경할 필드가 없는지 확인합니다.
+func (d ProfileDiff) IsEmpty() bool {
+	return d.Folder == nil && d.Tags == nil && d.Cookies == nil
+}
+
+// NameCount 는 폴더/태그 이름과 해당 프로필 수입니다.
+type NameCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// ProfileTaxonomy 는 전체 프로필의 폴더와 태그 목록입니다.
+type ProfileTaxonomy struct {
+	Folders []NameCount `json:"folders"`
+	Tags    []NameCount `json:"tags"`
+}
+
+// ProfileUpdateResult 는 일괄 수정에서 프로필 하나의 결과입니다.
+type ProfileUpdateResult struct {
+	ProfileID string `json:"profile_id"`
+	Name      string `json:"name"`
+	Changed   bool   `json:"changed"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ApplyProfileDiff 는 변경된 필드만 ModifyProfile 과 같은 엔드포인트로 전송합니다.
+// 공급자가 지원하지 않는 필드가 있으면 전송하지 않고 ErrNotSupported 를 반환합니다.
+func (bm *BrowserManager) ApplyProfileDiff(profileID string, diff ProfileDiff) (map[string]interface{}, error) {
+	if err := bm.requireDiff(diff); err != nil {
+		return nil, err
+	}
+	if diff.Cookies != nil {
+		cookies, err := NormalizeCookies(*diff.Cookies)
+		if err != nil {
+			return nil, err
+		}
+		diff.Cookies = &cookies
+	}
+	return bm.updateProfile(profileID, diff)
+}
+
+// requireDiff 는 diff 에 채워진 필드마다 공급자 지원 여부를 확인합니다.
+func (bm *BrowserManager) requireDiff(diff ProfileDiff) error {
+	if diff.Folder != nil {
+		if err := bm.requireFolders(); err != nil {
+			return err
+		}
+	}
+	if diff.Tags != nil {
+		if err := bm.requireTags(); err != nil {
+			return err
+		}
+	}
+	if diff.Cookies != nil {
+		if err := bm.require("cookies", func(c Capabilities) bool { return c.Cookies }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bm *BrowserManager) requireFolders() error {
+	return bm.require("folders", func(c Capabilities) bool { return c.Folders })
+}
+
+func (bm *BrowserManager) requireTags() error {
+	return bm.require("tags", func(c Capabilities) bool { return c.Tags })
+}
+
+// ListFoldersAndTags 는 모든 폴더와 태그를 프로필 수와 함께 이름 순으로 반환합니다.
+func (bm *BrowserManager) ListFoldersAndTags() (*ProfileTaxonomy, error) {
+	resp, err := bm.FetchProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	folders := make(map[string]int)
+	tags := make(map[string]int)
+	for _, p := range resp.Data {
+		if p.Folder != "" {
+			folders[p.Folder]++
+		}
+		for _, t := range p.Tags {
+			tags[t]++
+		}
+	}
+
+	return &ProfileTaxonomy{Folders: sortedCounts(folders), Tags: sortedCounts(tags)}, nil
+}
+
+// MoveProfiles 는 선택한 프로필을 folder 로 옮깁니다. 빈 문자열은 폴더 없음입니다.
+func (bm *BrowserManager) MoveProfiles(profileIDs []string, folder string) ([]ProfileUpdateResult, error) {
+	if err := bm.requireFolders(); err != nil {
+		return nil, err
+	}
+	folder = strings.TrimSpace(folder)
+	return bm.updateSelected(profileIDs, func(p Profile) ProfileDiff {
+		if p.Folder == folder {
+			return ProfileDiff{}
+		}
+		return ProfileDiff{Folder: &folder}
+	})
+}
+
+// AddTags 는 선택한 프로필에 태그를 추가합니다.
+func (bm *BrowserManager) AddTags(profileIDs []string, tags []string) ([]ProfileUpdateResult, error) {
+	if err := bm.requireTags(); err != nil {
+		return nil, err
+	}
+	tags, err := cleanTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	return bm.updateSelected(profileIDs, func(p Profile) ProfileDiff {
+		updated := append([]string{}, p.Tags...)
+		for _, t := range tags {
+			if !containsTag(updated, t) {
+				updated = append(updated, t)
+			}
+		}
+		return tagsDiff(p.Tags, updated)
+	})
+}
+
+// RemoveTags 는 선택한 프로필에서 태그를 제거합니다.
+func (bm *BrowserManager) RemoveTags(profileIDs []string, tags []string) ([]ProfileUpdateResult, error) {
+	if err := bm.requireTags(); err != nil {
+		return nil, err
+	}
+	tags, err := cleanTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	return bm.updateSelected(profileIDs, func(p Profile) ProfileDiff {
+		updated := []string{}
+		for _, t := range p.Tags {
+			if !containsTag(tags, t) {
+				updated = append(updated, t)
+			}
+		}
+		return tagsDiff(p.Tags, updated)
+	})
+}
+
+// RenameTag 는 모든 프로필에서 태그 이름을 바꿉니다. 이미 새 이름을 가진 프로필은 중복되지 않게 합칩니다.
+func (bm *BrowserManager) RenameTag(oldName, newName string) ([]ProfileUpdateResult, error) {
+	if err := bm.requireTags(); err != nil {
+		return nil, err
+	}
+	names, err := cleanTags([]string{oldName, newName})
+	if err != nil {
+		return nil, err
+	}
+	oldName, newName = names[0], names[1]
+	if oldName == newName {
+		return []ProfileUpdateResult{}, nil
+	}
+
+	resp, err := bm.FetchProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for id, p := range resp.Data {
+		if containsTag(p.Tags, oldName) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	bm.logger.Info("Renaming tag", zap.String("from", oldName), zap.String("to", newName), zap.Int("profiles", len(ids)))
+	return bm.applyDiffs(resp.Data, ids, func(p Profile) ProfileDiff {
+		updated := []string{}
+		for _, t := range p.Tags {
+			if t == oldName {
+				t = newName
+			}
+			if !containsTag(updated, t) {
+				updated = append(updated, t)
+			}
+		}
+		return tagsDiff(p.Tags, updated)
+	}), nil
+}
+
+// updateSelected 는 현재 프로필 목록을 가져와 선택한 프로필에 diff 를 적용합니다.
+func (bm *BrowserManager) updateSelected(profileIDs []string, diffFor func(Profile) ProfileDiff) ([]ProfileUpdateResult, error) {
+	if len(profileIDs) == 0 {
+		return nil, fmt.Errorf("no profiles selected")
+	}
+	resp, err := bm.FetchProfiles()
+	if err != nil {
+		return nil, err
+	}
+	return bm.applyDiffs(resp.Data, profileIDs, diffFor), nil
+}
+
+// applyDiffs 는 프로필별로 diff 를 계산해 변경이 있는 프로필만 수정하고 결과를 모읍니다.
+func (bm *BrowserManager) applyDiffs(profiles map[string]Profile, profileIDs []string, diffFor func(Profile) ProfileDiff) []ProfileUpdateResult {
+	results := make([]ProfileUpdateResult, 0, len(profileIDs))
+	for _, id := range profileIDs {
+		p, ok := profiles[id]
+		if !ok {
+			results = append(results, ProfileUpdateResult{ProfileID: id, Error: ErrProfileNotFound.Error()})
+			continue
+		}
+
+		result := ProfileUpdateResult{ProfileID: id, Name: p.Name}
+		diff := diffFor(p)
+		if !diff.IsEmpty() {
+			if _, err := bm.ApplyProfileDiff(id, diff); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Changed = true
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// tagsDiff 는 태그가 실제로 바뀐 경우에만 diff 를 만듭니다.
+func tagsDiff(before, after []string) ProfileDiff {
+	if len(before) == len(after) {
+		same := true
+		for i := range before {
+			if before[i] != after[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return ProfileDiff{}
+		}
+	}
+	return ProfileDiff{Tags: &after}
+}
+
+func cleanTags(tags []string) ([]string, error) {
+	cleaned := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			return nil, fmt.Errorf("tag name must not be empty")
+		}
+		cleaned = append(cleaned, t)
+	}
+	return cleaned, nil
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedCounts(counts map[string]int) []NameCount {
+	out := make([]NameCount, 0, len(counts))
+	for name, count := range counts {
+		out = append(out, NameCount{Name: name, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
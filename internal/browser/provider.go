@@ -0,0 +1,230 @@
+// internal/browser/provider.go
+
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+// 지원하는 안티디텍트 브라우저 공급자 이름
+const (
+	ProviderUndetectable = "undetectable"
+	ProviderHideMyAcc    = "hidemyacc"
+)
+
+// ErrNotSupported 는 현재 공급자가 지원하지 않는 작업을 요청했을 때 반환됩니다.
+var ErrNotSupported = errors.New("not supported by the browser provider")
+
+// ErrProfileNotFound 는 공급자에 해당 ID 의 프로필이 없을 때 반환됩니다.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Capabilities 는 공급자가 지원하는 기능입니다. UI 는 지원하지 않는 기능의 버튼을 숨깁니다.
+type Capabilities struct {
+	// Update 는 기존 프로필 설정 수정 지원 여부입니다.
+	Update bool `json:"update"`
+	// Cookies 는 프로필 생성/수정 시 쿠키 주입 지원 여부입니다.
+	Cookies bool `json:"cookies"`
+	// Folders, Tags 는 프로필 정리(폴더 이동, 태그) 지원 여부입니다.
+	Folders bool `json:"folders"`
+	Tags    bool `json:"tags"`
+	// Debugger 는 실행 중인 프로필의 CDP 연결 정보(DebugPort/WebsocketLink) 제공 여부입니다.
+	Debugger bool `json:"debugger"`
+}
+
+// ProviderInfo 는 UI 에 보여 줄 공급자 정보입니다.
+type ProviderInfo struct {
+	Name         string       `json:"name"`
+	Active       bool         `json:"active"`
+	Capabilities Capabilities `json:"capabilities"`
+}
+
+// Provider 는 안티디텍트 브라우저의 로컬 API 입니다. BrowserManager 는 공급자에 상관없이 같은 형태
+// (ProfileResponse, Profile, 응답 map)로 결과를 돌려주며, 감사 로그와 실행 목록 관리는 BrowserManager 가 합니다.
+// 생성/실행 응답 map 에는 가능하면 "profile_id", "debug_port", "websocket_link" 키를 채웁니다.
+type Provider interface {
+	Name() string
+	Capabilities() Capabilities
+	List(ctx context.Context) (*ProfileResponse, error)
+	Info(ctx context.Context, profileID string) (*ProfileInfoResponse, error)
+	Create(ctx context.Context, req CreateProfileRequest) (map[string]interface{}, error)
+	Update(ctx context.Context, profileID string, body interface{}) (map[string]interface{}, error)
+	Delete(ctx context.Context, profileID string) (map[string]interface{}, error)
+	Start(ctx context.Context, profileID string) (map[string]interface{}, error)
+	Stop(ctx context.Context, profileID string) (map[string]interface{}, error)
+}
+
+// AccountProvider 는 로그인한 공급자 계정 정보를 조회할 수 있는 공급자입니다.
+type AccountProvider interface {
+	Account(ctx context.Context) (map[string]interface{}, error)
+}
+
+// ProfileOptions 는 공급자가 프로필 생성에 받는 값 목록입니다. 공급자가 알려 주지 않은 항목은 빈 목록이며
+// 검사하지 않습니다.
+type ProfileOptions struct {
+	OS       []string `json:"os"`
+	Browsers []string `json:"browsers"`
+	CPUs     []int    `json:"cpus"`
+	Memory   []int    `json:"memory"`
+}
+
+// OptionsProvider 는 프로필 생성에 받는 옵션 값을 로컬 API 에서 조회할 수 있는 공급자입니다.
+type OptionsProvider interface {
+	ProfileOptions(ctx context.Context) (*ProfileOptions, error)
+}
+
+// transport 는 공급자가 로컬 API 에 요청을 보내는 경로입니다. BrowserManager 가 재시도와 회로 차단기를 적용합니다.
+type transport interface {
+	// get 은 연결 오류 시 재시도하는 멱등 GET 요청입니다.
+	get(ctx context.Context, url string) (*http.Response, error)
+	// call 은 재시도하지 않는 요청입니다.
+	call(ctx context.Context, method, url string, body []byte) (*http.Response, error)
+}
+
+// NewProvider 는 이름에 해당하는 공급자를 bm 의 재시도와 공급자별 회로 차단기를 거치도록 만듭니다.
+func NewProvider(name string, bm *BrowserManager) (Provider, error) {
+	switch name {
+	case ProviderUndetectable:
+		return newUndetectable(bm.transport(name), BASE_URL), nil
+	case ProviderHideMyAcc:
+		return newHideMyAcc(bm.transport(name), HideMyAccBaseURL), nil
+	}
+	return nil, fmt.Errorf("unknown browser provider %q", name)
+}
+
+// ProviderNames 는 지원하는 공급자 이름을 정렬해 반환합니다.
+func ProviderNames() []string {
+	names := []string{ProviderUndetectable, ProviderHideMyAcc}
+	sort.Strings(names)
+	return names
+}
+
+// Provider 는 현재 공급자를 반환합니다.
+func (bm *BrowserManager) Provider() Provider {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	return bm.provider
+}
+
+// SetProvider 는 공급자를 바꿉니다. 테스트에서는 가짜 공급자를 넣을 수 있습니다.
+// API 상태는 새 공급자의 회로 차단기 상태로 바뀌므로 OnAPIStateChange 로 알립니다.
+func (bm *BrowserManager) SetProvider(p Provider) {
+	bm.mu.Lock()
+	bm.provider = p
+	fn := bm.onAPIState
+	cb := bm.breakers[p.Name()]
+	bm.mu.Unlock()
+
+	bm.logger.Info("Browser provider selected", zap.String("provider", p.Name()))
+	if fn != nil {
+		state := BreakerClosed
+		if cb != nil {
+			state = cb.current()
+		}
+		go fn(state)
+	}
+}
+
+// UseProvider 는 이름으로 공급자를 골라 SetProvider 합니다. 이번 세션에서 실행한 프로필이 있으면
+// 종료 시 정리할 수 없게 되므로 바꾸지 않습니다.
+func (bm *BrowserManager) UseProvider(name string) error {
+	p, err := NewProvider(name, bm)
+	if err != nil {
+		return err
+	}
+	if bm.Provider().Name() == name {
+		return nil
+	}
+	if launched := bm.LaunchedProfiles(); len(launched) > 0 {
+		return fmt.Errorf("stop the %d running profile(s) before switching provider", len(launched))
+	}
+	bm.SetProvider(p)
+	return nil
+}
+
+// providerSetting 은 선택한 공급자를 재시작 후에도 유지하기 위한 파일 형식입니다.
+type providerSetting struct {
+	Provider string `json:"provider"`
+}
+
+// LoadProviderSetting 은 path 에 저장된 공급자 이름을 읽습니다. 파일이 없으면 빈 문자열을 반환합니다.
+func LoadProviderSetting(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read provider setting: %w", err)
+	}
+	var setting providerSetting
+	if err := json.Unmarshal(data, &setting); err != nil {
+		return "", fmt.Errorf("failed to parse provider setting: %w", err)
+	}
+	return setting.Provider, nil
+}
+
+// SaveProviderSetting 은 공급자 이름을 path 에 저장합니다.
+func SaveProviderSetting(path, name string) error {
+	data, err := json.MarshalIndent(providerSetting{Provider: name}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(path, data, 0600)
+}
+
+// Providers 는 지원하는 공급자와 현재 선택 여부를 반환합니다.
+func (bm *BrowserManager) Providers() []ProviderInfo {
+	active := bm.Provider().Name()
+	var infos []ProviderInfo
+	for _, name := range ProviderNames() {
+		p, _ := NewProvider(name, bm)
+		infos = append(infos, ProviderInfo{Name: name, Active: name == active, Capabilities: p.Capabilities()})
+	}
+	return infos
+}
+
+// ProviderAccount 는 name 공급자의 로그인 계정 정보를 조회합니다. 현재 공급자가 아니어도 조회할 수 있습니다.
+func (bm *BrowserManager) ProviderAccount(name string) (map[string]interface{}, error) {
+	p, err := NewProvider(name, bm)
+	if err != nil {
+		return nil, err
+	}
+	ap, ok := p.(AccountProvider)
+	if !ok {
+		return nil, fmt.Errorf("%s account info: %w", name, ErrNotSupported)
+	}
+	return ap.Account(bm.ctx)
+}
+
+// require 는 현재 공급자가 op 를 지원하지 않으면 ErrNotSupported 를 반환합니다.
+func (bm *BrowserManager) require(op string, supported func(Capabilities) bool) error {
+	p := bm.Provider()
+	if !supported(p.Capabilities()) {
+		return fmt.Errorf("%s: %s: %w", p.Name(), op, ErrNotSupported)
+	}
+	return nil
+}
+
+// decodeJSON 은 응답 본문을 v 로 디코딩합니다.
+func decodeJSON(resp *http.Response, v interface{}, what string) error {
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", what, err)
+	}
+	return nil
+}
+
+// Ping 은 현재 공급자의 로컬 API 에 프로필 목록을 요청해 응답하는지 확인합니다.
+func (bm *BrowserManager) Ping(ctx context.Context) error {
+	_, err := bm.listProfiles(ctx)
+	return err
+}
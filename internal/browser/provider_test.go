@@ -0,0 +1,242 @@
+// internal/browser/provider_test.go
+
+package browser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+// fakeProvider 는 요청을 기록하고 메모리의 프로필로 응답하는 가짜 공급자입니다.
+type fakeProvider struct {
+	mu       sync.Mutex
+	name     string
+	caps     Capabilities
+	profiles map[string]Profile
+	updates  map[string][]interface{}
+	created  []CreateProfileRequest
+	// createErr 가 있으면 Create 는 공급자가 요청을 거부한 것처럼 그 오류를 반환합니다.
+	createErr func(CreateProfileRequest) error
+	started   []string
+	stopped   []string
+}
+
+func newFakeProvider(name string, caps Capabilities, profiles ...Profile) *fakeProvider {
+	f := &fakeProvider{name: name, caps: caps, profiles: make(map[string]Profile), updates: make(map[string][]interface{})}
+	for _, p := range profiles {
+		f.profiles[p.ID] = p
+	}
+	return f
+}
+
+func (f *fakeProvider) Name() string               { return f.name }
+func (f *fakeProvider) Capabilities() Capabilities { return f.caps }
+
+func (f *fakeProvider) List(ctx context.Context) (*ProfileResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data := make(map[string]Profile, len(f.profiles))
+	for id, p := range f.profiles {
+		data[id] = p
+	}
+	return &ProfileResponse{Status: "success", Data: data}, nil
+}
+
+func (f *fakeProvider) Info(ctx context.Context, profileID string) (*ProfileInfoResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p, ok := f.profiles[profileID]
+	if !ok {
+		return nil, ErrProfileNotFound
+	}
+	return &ProfileInfoResponse{Status: "success", Data: p}, nil
+}
+
+func (f *fakeProvider) Create(ctx context.Context, req CreateProfileRequest) (map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.createErr != nil {
+		if err := f.createErr(req); err != nil {
+			return nil, err
+		}
+	}
+	f.created = append(f.created, req)
+	id := fmt.Sprintf("new%d", len(f.created))
+	f.profiles[id] = Profile{ID: id, Name: req.Name}
+	return map[string]interface{}{"status": "success", "profile_id": id}, nil
+}
+
+func (f *fakeProvider) Update(ctx context.Context, profileID string, body interface{}) (map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updates[profileID] = append(f.updates[profileID], body)
+	return map[string]interface{}{"status": "success"}, nil
+}
+
+func (f *fakeProvider) Delete(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	return map[string]interface{}{"status": "success"}, nil
+}
+
+func (f *fakeProvider) Start(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.started = append(f.started, profileID)
+	return map[string]interface{}{"status": "success"}, nil
+}
+
+func (f *fakeProvider) Stop(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopped = append(f.stopped, profileID)
+	return map[string]interface{}{"status": "success"}, nil
+}
+
+func (f *fakeProvider) updateCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, bodies := range f.updates {
+		n += len(bodies)
+	}
+	return n
+}
+
+// newTestManager 는 재시도 없이 fake 공급자를 쓰는 BrowserManager 를 만듭니다.
+func newTestManager(t *testing.T, p Provider) *BrowserManager {
+	t.Helper()
+	bm := NewBrowserManager(context.Background(), zap.NewNop())
+	bm.SetRetryPolicy(RetryPolicy{MaxAttempts: 1})
+	if p != nil {
+		bm.SetProvider(p)
+	}
+	return bm
+}
+
+func TestApplyProfileDiffChecksCapabilities(t *testing.T) {
+	fake := newFakeProvider("fake", Capabilities{Update: true, Tags: true}, Profile{ID: "p1"})
+	bm := newTestManager(t, fake)
+
+	folder := "work"
+	cookies := []Cookie{{Name: "sid", Value: "1", Domain: ".example.com", Path: "/"}}
+	tags := []string{"a"}
+
+	tests := []struct {
+		name      string
+		diff      ProfileDiff
+		supported bool
+	}{
+		{"folder", ProfileDiff{Folder: &folder}, false},
+		{"cookies", ProfileDiff{Cookies: &cookies}, false},
+		{"tags and folder", ProfileDiff{Tags: &tags, Folder: &folder}, false},
+		{"tags", ProfileDiff{Tags: &tags}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := fake.updateCount()
+			_, err := bm.ApplyProfileDiff("p1", tt.diff)
+			if tt.supported {
+				if err != nil {
+					t.Fatalf("ApplyProfileDiff: %v", err)
+				}
+				if fake.updateCount() != before+1 {
+					t.Fatalf("update was not sent")
+				}
+				return
+			}
+			if !errors.Is(err, ErrNotSupported) {
+				t.Fatalf("err = %v, want ErrNotSupported", err)
+			}
+			if fake.updateCount() != before {
+				t.Fatalf("unsupported diff was sent to the provider")
+			}
+		})
+	}
+}
+
+func TestMoveProfilesUnsupported(t *testing.T) {
+	fake := newFakeProvider("fake", Capabilities{Update: true, Tags: true}, Profile{ID: "p1"})
+	bm := newTestManager(t, fake)
+
+	if _, err := bm.MoveProfiles([]string{"p1"}, "work"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("MoveProfiles err = %v, want ErrNotSupported", err)
+	}
+	results, err := bm.AddTags([]string{"p1"}, []string{"vip"})
+	if err != nil {
+		t.Fatalf("AddTags: %v", err)
+	}
+	if len(results) != 1 || !results[0].Changed {
+		t.Fatalf("AddTags results = %+v, want one changed profile", results)
+	}
+}
+
+func TestUseProviderRefusesWhileProfilesLaunched(t *testing.T) {
+	fake := newFakeProvider("fake", Capabilities{}, Profile{ID: "p1"})
+	bm := newTestManager(t, fake)
+
+	if _, err := bm.LaunchProfile("p1"); err != nil {
+		t.Fatalf("LaunchProfile: %v", err)
+	}
+	if err := bm.UseProvider(ProviderHideMyAcc); err == nil {
+		t.Fatalf("UseProvider switched while a profile was running")
+	}
+	if got := bm.Provider().Name(); got != "fake" {
+		t.Fatalf("provider = %q, want fake", got)
+	}
+
+	if _, err := bm.StopProfile("p1"); err != nil {
+		t.Fatalf("StopProfile: %v", err)
+	}
+	if err := bm.UseProvider(ProviderHideMyAcc); err != nil {
+		t.Fatalf("UseProvider: %v", err)
+	}
+	if got := bm.Provider().Name(); got != ProviderHideMyAcc {
+		t.Fatalf("provider = %q, want %q", got, ProviderHideMyAcc)
+	}
+	if err := bm.UseProvider("unknown"); err == nil {
+		t.Fatalf("UseProvider accepted an unknown provider")
+	}
+}
+
+// 한 공급자의 연결 실패가 다른 공급자의 회로 차단기를 열지 않아야 합니다.
+func TestCircuitBreakerPerProvider(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	deadURL := dead.URL
+	dead.Close()
+
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":0,"status":"success","data":{}}`))
+	}))
+	defer live.Close()
+
+	bm := newTestManager(t, nil)
+	hma := newHideMyAcc(bm.transport(ProviderHideMyAcc), deadURL)
+	for i := 0; i < DefaultBreakerOptions.FailureThreshold; i++ {
+		if _, err := hma.Account(context.Background()); err == nil {
+			t.Fatalf("request to a closed server succeeded")
+		}
+	}
+	if _, err := hma.Account(context.Background()); !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("err = %v, want open breaker", err)
+	}
+
+	if state := bm.APIState(); state != BreakerClosed {
+		t.Fatalf("APIState for %s = %q, want closed", bm.Provider().Name(), state)
+	}
+	und := newUndetectable(bm.transport(ProviderUndetectable), live.URL)
+	if _, err := und.List(context.Background()); err != nil {
+		t.Fatalf("undetectable List after hidemyacc failures: %v", err)
+	}
+
+	// 공급자를 바꾸면 그 공급자의 상태가 보입니다.
+	bm.SetProvider(hma)
+	if state := bm.APIState(); state != BreakerOpen {
+		t.Fatalf("APIState after switching = %q, want open", state)
+	}
+}
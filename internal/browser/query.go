@@ -0,0 +1,142 @@
+// internal/browser/query.go
+
+package browser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 정렬 기준
+const (
+	SortByName     = "name"
+	SortByCreated  = "created"
+	SortByModified = "modified"
+)
+
+// ProfileQuery 는 프로필 목록의 필터, 정렬, 페이지 조건입니다. 빈 값인 조건은 무시됩니다.
+type ProfileQuery struct {
+	Status  string   `json:"status"`
+	Folder  string   `json:"folder"`
+	Tags    []string `json:"tags"` // 모든 태그를 가진 프로필만
+	Browser string   `json:"browser"`
+	OS      string   `json:"os"`
+	Search  string   `json:"search"` // 이름/메모 부분 일치 (대소문자 무시)
+	SortBy  string   `json:"sort_by"`
+	Desc    bool     `json:"desc"`
+	Offset  int      `json:"offset"`
+	Limit   int      `json:"limit"` // 0 이면 전체
+}
+
+// ProfilePage 는 조회 결과 한 페이지입니다. Total 은 페이지 적용 전 일치한 개수입니다.
+type ProfilePage struct {
+	Total    int       `json:"total"`
+	Profiles []Profile `json:"profiles"`
+}
+
+// QueryProfiles 는 프로필 목록을 가져와 조건에 맞게 필터/정렬/페이지 처리합니다.
+func (bm *BrowserManager) QueryProfiles(q ProfileQuery) (*ProfilePage, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	resp, err := bm.FetchProfiles()
+	if err != nil {
+		return nil, err
+	}
+	return FilterProfiles(resp.Data, q), nil
+}
+
+// Validate 는 정렬 기준과 페이지 값이 올바른지 확인합니다.
+func (q ProfileQuery) Validate() error {
+	switch q.SortBy {
+	case "", SortByName, SortByCreated, SortByModified:
+	default:
+		return fmt.Errorf("unknown sort field %q", q.SortBy)
+	}
+	if q.Offset < 0 || q.Limit < 0 {
+		return fmt.Errorf("offset and limit must not be negative")
+	}
+	return nil
+}
+
+// FilterProfiles 는 FetchProfiles 결과(map)를 조건에 맞는 정렬된 슬라이스로 변환합니다.
+// 정렬 값이 같으면 ID 순으로 정렬해 항상 같은 순서를 보장합니다.
+func FilterProfiles(profiles map[string]Profile, q ProfileQuery) *ProfilePage {
+	search := strings.ToLower(strings.TrimSpace(q.Search))
+
+	matched := make([]Profile, 0, len(profiles))
+	for id, p := range profiles {
+		p.ID = id
+		if q.Status != "" && !strings.EqualFold(p.Status, q.Status) {
+			continue
+		}
+		if q.Folder != "" && p.Folder != q.Folder {
+			continue
+		}
+		if q.Browser != "" && !strings.EqualFold(p.Browser, q.Browser) {
+			continue
+		}
+		if q.OS != "" && !strings.EqualFold(p.OS, q.OS) {
+			continue
+		}
+		if !hasAllTags(p.Tags, q.Tags) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(p.Name), search) && !strings.Contains(strings.ToLower(p.Notes), search) {
+			continue
+		}
+		matched = append(matched, p)
+	}
+
+	less := profileLess(q.SortBy)
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if q.Desc {
+			a, b = b, a
+		}
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.ID < b.ID
+	})
+
+	page := &ProfilePage{Total: len(matched)}
+	start := min(q.Offset, len(matched))
+	end := len(matched)
+	if q.Limit > 0 {
+		end = min(start+q.Limit, len(matched))
+	}
+	page.Profiles = matched[start:end]
+	return page
+}
+
+func profileLess(sortBy string) func(a, b Profile) bool {
+	switch sortBy {
+	case SortByCreated:
+		return func(a, b Profile) bool { return a.CreationDate < b.CreationDate }
+	case SortByModified:
+		return func(a, b Profile) bool { return a.ModifyDate < b.ModifyDate }
+	default:
+		return func(a, b Profile) bool { return strings.ToLower(a.Name) < strings.ToLower(b.Name) }
+	}
+}
+
+func hasAllTags(have, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -3,16 +3,16 @@
 package browser
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
 	"sync"
 
+	"cookieBot/internal/audit"
+
 	"go.uber.org/zap"
 )
 
+// BASE_URL 은 Undetectable 로컬 API 주소입니다.
 const BASE_URL = "http://localhost:25325"
 
 // BrowserManager 구조체 정의
@@ -20,38 +20,77 @@ type BrowserManager struct {
 	ctx    context.Context
 	logger *zap.Logger
 	mu     sync.Mutex
+
+	// 이번 세션에서 실행한 프로필 ID
+	launched map[string]struct{}
+
+	retry RetryPolicy
+	// 공급자 이름별 회로 차단기. 한 공급자의 장애가 다른 공급자 요청을 막지 않도록 따로 둡니다.
+	breakers   map[string]*circuitBreaker
+	onAPIState func(BreakerState)
+	audit      *audit.Log
+	provider   Provider
 }
 
 // NewBrowserManager 함수 정의
+// 기본 공급자는 Undetectable 이며 SetProvider/UseProvider 로 바꿀 수 있습니다.
 func NewBrowserManager(ctx context.Context, logger *zap.Logger) *BrowserManager {
-	return &BrowserManager{
-		ctx:    ctx,
-		logger: logger,
+	bm := &BrowserManager{
+		ctx:      ctx,
+		logger:   logger,
+		launched: make(map[string]struct{}),
+		retry:    DefaultRetryPolicy,
+		breakers: make(map[string]*circuitBreaker),
 	}
+	bm.provider = newUndetectable(bm.transport(ProviderUndetectable), BASE_URL)
+	return bm
+}
+
+// SetAuditLog 는 프로필 생성/수정/실행/종료/삭제를 기록할 감사 로그를 지정합니다.
+func (bm *BrowserManager) SetAuditLog(l *audit.Log) {
+	bm.audit = l
+}
+
+// auditParams 는 요청 본문을 감사 로그용 파라미터로 바꿉니다. 값 정리는 audit.Redact 가 합니다.
+func auditParams(body interface{}) map[string]interface{} {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil
+	}
+	return params
 }
 
 // Profile 구조체 정의
 type Profile struct {
-	Name          string   `json:"name"`
-	Status        string   `json:"status"`
-	DebugPort     string   `json:"debug_port"`
-	WebsocketLink string   `json:"websocket_link"`
-	Folder        string   `json:"folder"`
-	Tags          []string `json:"tags"`
-	CloudID       string   `json:"cloud_id"`
-	CreationDate  int64    `json:"creation_date"`
-	ModifyDate    int64    `json:"modify_date"`
-	ConfigID      string   `json:"configid"`  // 추가된 필드
-	Type          string   `json:"type"`      // 추가된 필드
-	Proxy         string   `json:"proxy"`     // 추가된 필드
-	Notes         string   `json:"notes"`     // 추가된 필드
-	UserAgent     string   `json:"useragent"` // 추가된 필드
-	Browser       string   `json:"browser"`   // 추가된 필드
-	OS            string   `json:"os"`        // 추가된 필드
-	Screen        string   `json:"screen"`    // 추가된 필드
-	Language      string   `json:"language"`  // 추가된 필드
-	CPU           int      `json:"cpu"`       // 추가된 필드
-	Memory        int      `json:"memory"`    // 추가된 필드
+	ID            string    `json:"id"` // 목록 응답에서는 map 키, 상세 응답에서는 요청한 ID
+	Name          string    `json:"name"`
+	Status        string    `json:"status"`
+	DebugPort     string    `json:"debug_port"`
+	WebsocketLink string    `json:"websocket_link"`
+	Folder        string    `json:"folder"`
+	Tags          []string  `json:"tags"`
+	CloudID       string    `json:"cloud_id"`
+	CreationDate  int64     `json:"creation_date"`
+	ModifyDate    int64     `json:"modify_date"`
+	ConfigID      string    `json:"configid"`  // 추가된 필드
+	Type          string    `json:"type"`      // 추가된 필드
+	Proxy         string    `json:"proxy"`     // 추가된 필드
+	Notes         string    `json:"notes"`     // 추가된 필드
+	UserAgent     string    `json:"useragent"` // 추가된 필드
+	Browser       string    `json:"browser"`   // 추가된 필드
+	OS            string    `json:"os"`        // 추가된 필드
+	Screen        string    `json:"screen"`    // 추가된 필드
+	Language      string    `json:"language"`  // 추가된 필드
+	CPU           int       `json:"cpu"`       // 추가된 필드
+	Memory        int       `json:"memory"`    // 추가된 필드
+	Geolocation   string    `json:"geolocation,omitempty"`
+	Timezone      string    `json:"timezone,omitempty"`
+	Group         string    `json:"group,omitempty"`
+	Accounts      []Account `json:"accounts,omitempty"`
 }
 
 // ProfileResponse 구조체 정의
@@ -68,10 +107,18 @@ type ProfileInfoResponse struct {
 	Data   Profile `json:"data"` // 수정된 Profile 구조체 사용
 }
 
-// Cookie 구조체 정의
+// Cookie 구조체 정의 (브라우저 확장/Undetectable 에서 쓰는 JSON 쿠키 형식)
 type Cookie struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name           string  `json:"name"`
+	Value          string  `json:"value"`
+	Domain         string  `json:"domain"`
+	Path           string  `json:"path"`
+	ExpirationDate float64 `json:"expirationDate,omitempty"` // Unix 초, 세션 쿠키는 0
+	HostOnly       bool    `json:"hostOnly"`
+	HTTPOnly       bool    `json:"httpOnly"`
+	Secure         bool    `json:"secure"`
+	Session        bool    `json:"session"`
+	SameSite       string  `json:"sameSite,omitempty"` // no_restriction, lax, strict, unspecified
 }
 
 // Account 구조체 정의
@@ -106,152 +153,148 @@ type CreateProfileRequest struct {
 // FetchProfiles 메서드 정의
 func (bm *BrowserManager) FetchProfiles() (*ProfileResponse, error) {
 	bm.logger.Info("Fetching profiles from the server")
-	resp, err := http.Get(fmt.Sprintf("%s/list", BASE_URL))
+	profileResponse, err := bm.listProfiles(bm.ctx)
 	if err != nil {
 		bm.logger.Error("Failed to fetch profiles", zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var profileResponse ProfileResponse
-	if err := json.NewDecoder(resp.Body).Decode(&profileResponse); err != nil {
-		bm.logger.Error("Failed to decode profile response", zap.Error(err))
-		return nil, err
-	}
 	bm.logger.Info("Successfully fetched profiles", zap.Int("count", len(profileResponse.Data)))
-	return &profileResponse, nil
+	return profileResponse, nil
+}
+
+// listProfiles 는 로그 없이 프로필 목록을 조회합니다. 주기적으로 호출하는 상태 감시에서도 사용합니다.
+func (bm *BrowserManager) listProfiles(ctx context.Context) (*ProfileResponse, error) {
+	return bm.Provider().List(ctx)
 }
 
 // FetchProfileInfo 메서드 정의 (프로필 정보 요청)
 func (bm *BrowserManager) FetchProfileInfo(profileID string) (*ProfileInfoResponse, error) {
 	bm.logger.Info("Fetching profile info", zap.String("profileID", profileID))
-	resp, err := http.Get(fmt.Sprintf("%s/profile/getinfo/%s", BASE_URL, profileID))
+	profileInfoResponse, err := bm.Provider().Info(bm.ctx, profileID)
 	if err != nil {
 		bm.logger.Error("Failed to fetch profile info", zap.String("profileID", profileID), zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var profileInfoResponse ProfileInfoResponse
-	if err := json.NewDecoder(resp.Body).Decode(&profileInfoResponse); err != nil {
-		bm.logger.Error("Failed to decode profile info response", zap.Error(err))
-		return nil, err
-	}
 	bm.logger.Info("Successfully fetched profile info", zap.String("profileID", profileID))
-	return &profileInfoResponse, nil
+	return profileInfoResponse, nil
 }
 
 // AddProfile 메서드 정의
-func (bm *BrowserManager) AddProfile(req CreateProfileRequest) (map[string]interface{}, error) {
+func (bm *BrowserManager) AddProfile(req CreateProfileRequest) (response map[string]interface{}, err error) {
+	defer func() {
+		target, _ := response["profile_id"].(string)
+		bm.audit.Record("profile.create", target, auditParams(req), err)
+	}()
+
 	bm.logger.Info("Creating new profile", zap.String("name", req.Name))
-	jsonData, err := json.Marshal(req)
+	if len(req.Cookies) > 0 {
+		if err := bm.require("cookies", func(c Capabilities) bool { return c.Cookies }); err != nil {
+			return nil, err
+		}
+	}
+	cookies, err := NormalizeCookies(req.Cookies)
 	if err != nil {
-		bm.logger.Error("Failed to marshal create profile request", zap.Error(err))
+		bm.logger.Error("Invalid cookies in create profile request", zap.Error(err))
 		return nil, err
 	}
+	req.Cookies = cookies
 
-	resp, err := http.Post(fmt.Sprintf("%s/profile/create", BASE_URL), "application/json", bytes.NewBuffer(jsonData))
+	response, err = bm.Provider().Create(bm.ctx, req)
 	if err != nil {
 		bm.logger.Error("Failed to create profile", zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var response map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		bm.logger.Error("Failed to decode create profile response", zap.Error(err))
-		return nil, err
-	}
-	bm.logger.Info("Successfully created profile", zap.String("profileID", response["profile_id"].(string)))
+	profileID, _ := response["profile_id"].(string)
+	bm.logger.Info("Successfully created profile", zap.String("profileID", profileID))
 	return response, nil
 }
 
 // LaunchProfile 메서드 정의
 func (bm *BrowserManager) LaunchProfile(profileID string) (map[string]interface{}, error) {
+	return bm.launchProfile(bm.ctx, profileID)
+}
+
+func (bm *BrowserManager) launchProfile(ctx context.Context, profileID string) (response map[string]interface{}, err error) {
+	defer func() { bm.audit.Record("profile.start", profileID, nil, err) }()
+
 	bm.logger.Info("Launching profile", zap.String("profileID", profileID))
-	resp, err := http.Get(fmt.Sprintf("%s/profile/start/%s", BASE_URL, profileID))
+	response, err = bm.Provider().Start(ctx, profileID)
 	if err != nil {
 		bm.logger.Error("Failed to launch profile", zap.String("profileID", profileID), zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var response map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		bm.logger.Error("Failed to decode launch profile response", zap.Error(err))
-		return nil, err
-	}
+	bm.trackLaunched(profileID, true)
 	bm.logger.Info("Successfully launched profile", zap.String("profileID", profileID))
 	return response, nil
 }
 
 // StopProfile 메서드 정의
 func (bm *BrowserManager) StopProfile(profileID string) (map[string]interface{}, error) {
+	return bm.stopProfile(bm.ctx, profileID)
+}
+
+func (bm *BrowserManager) stopProfile(ctx context.Context, profileID string) (response map[string]interface{}, err error) {
+	defer func() { bm.audit.Record("profile.stop", profileID, nil, err) }()
+
 	bm.logger.Info("Stop profile", zap.String("profileID", profileID))
-	resp, err := http.Get(fmt.Sprintf("%s/profile/stop/%s", BASE_URL, profileID))
+	response, err = bm.Provider().Stop(ctx, profileID)
 	if err != nil {
 		bm.logger.Error("Failed to stop profile", zap.String("profileID", profileID), zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var response map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		bm.logger.Error("Failed to decode terminate profile response", zap.Error(err))
-		return nil, err
-	}
+	bm.trackLaunched(profileID, false)
 	bm.logger.Info("Successfully stop profile", zap.String("profileID", profileID))
 	return response, nil
 }
 
 // ModifyProfile 메서드 정의
 func (bm *BrowserManager) ModifyProfile(profileID string, req CreateProfileRequest) (map[string]interface{}, error) {
-	bm.logger.Info("Modifying profile", zap.String("profileID", profileID))
-	jsonData, err := json.Marshal(req)
+	if len(req.Cookies) > 0 {
+		if err := bm.require("cookies", func(c Capabilities) bool { return c.Cookies }); err != nil {
+			return nil, err
+		}
+	}
+	cookies, err := NormalizeCookies(req.Cookies)
 	if err != nil {
-		bm.logger.Error("Failed to marshal modify profile request", zap.Error(err))
+		bm.logger.Error("Invalid cookies in modify profile request", zap.String("profileID", profileID), zap.Error(err))
 		return nil, err
 	}
+	req.Cookies = cookies
+	return bm.updateProfile(profileID, req)
+}
 
-	client := &http.Client{}
-	reqUpdate, err := http.NewRequest("POST", fmt.Sprintf("%s/profile/update/%s", BASE_URL, profileID), bytes.NewBuffer(jsonData))
-	if err != nil {
-		bm.logger.Error("Failed to create modify profile request", zap.Error(err))
+// updateProfile 은 공급자의 수정 API 로 body 를 전송합니다. body 에 포함된 필드만 변경됩니다.
+func (bm *BrowserManager) updateProfile(profileID string, body interface{}) (response map[string]interface{}, err error) {
+	defer func() { bm.audit.Record("profile.update", profileID, auditParams(body), err) }()
+
+	bm.logger.Info("Modifying profile", zap.String("profileID", profileID))
+	if err := bm.require("update", func(c Capabilities) bool { return c.Update }); err != nil {
 		return nil, err
 	}
-	reqUpdate.Header.Set("Content-Type", "application/json")
-
-	resp, err := client.Do(reqUpdate)
+	response, err = bm.Provider().Update(bm.ctx, profileID, body)
 	if err != nil {
 		bm.logger.Error("Failed to modify profile", zap.String("profileID", profileID), zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var response map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		bm.logger.Error("Failed to decode modify profile response", zap.Error(err))
-		return nil, err
-	}
 	bm.logger.Info("Successfully modified profile", zap.String("profileID", profileID))
 	return response, nil
 }
 
 // RemoveProfile 메서드 정의
 func (bm *BrowserManager) RemoveProfile(profileID string) (map[string]interface{}, error) {
+	return bm.removeProfile(bm.ctx, profileID)
+}
+
+func (bm *BrowserManager) removeProfile(ctx context.Context, profileID string) (response map[string]interface{}, err error) {
+	defer func() { bm.audit.Record("profile.delete", profileID, nil, err) }()
+
 	bm.logger.Info("Removing profile", zap.String("profileID", profileID))
-	resp, err := http.Get(fmt.Sprintf("%s/profile/delete/%s", BASE_URL, profileID))
+	response, err = bm.Provider().Delete(ctx, profileID)
 	if err != nil {
 		bm.logger.Error("Failed to remove profile", zap.String("profileID", profileID), zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var response map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		bm.logger.Error("Failed to decode remove profile response", zap.Error(err))
-		return nil, err
-	}
+	bm.trackLaunched(profileID, false)
 	bm.logger.Info("Successfully removed profile", zap.String("profileID", profileID))
 	return response, nil
 }
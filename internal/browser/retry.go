@@ -0,0 +1,320 @@
+// internal/browser/retry.go
+
+package browser
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// APIStateChanged 는 브라우저 API 회로 차단기 상태가 바뀔 때 발생하는 이벤트 이름입니다.
+const APIStateChanged = "browser:api-state"
+
+// BreakerState 는 브라우저 API 회로 차단기 상태입니다.
+type BreakerState string
+
+const (
+	// BreakerClosed 는 정상 상태입니다.
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen 은 연속 실패로 요청을 바로 거절하는 상태입니다. UI 에서는 "재연결 중" 으로 표시합니다.
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen 은 대기 시간이 지나 시험 요청 하나를 보내는 상태입니다.
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// ErrAPIUnavailable 은 브라우저 API 에 연결할 수 없을 때 errors.Is 로 확인할 수 있는 오류입니다.
+var ErrAPIUnavailable = errors.New("browser API unavailable")
+
+// APIUnavailableError 는 회로 차단기가 열려 요청을 보내지 않았거나, 재시도 후에도 연결에 실패했을 때 반환됩니다.
+type APIUnavailableError struct {
+	// RetryAfter 는 다음 시험 요청까지 남은 시간입니다. 재시도 실패로 반환된 경우 0 입니다.
+	RetryAfter time.Duration
+	Cause      error
+}
+
+func (e *APIUnavailableError) Error() string {
+	if e.Cause == nil {
+		return fmt.Sprintf("%s (retry in %s)", ErrAPIUnavailable, e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("%s: %v", ErrAPIUnavailable, e.Cause)
+}
+
+func (e *APIUnavailableError) Unwrap() error { return e.Cause }
+
+func (e *APIUnavailableError) Is(target error) bool { return target == ErrAPIUnavailable }
+
+// RetryPolicy 는 멱등 요청(list, getinfo, stop)의 재시도 방식입니다.
+type RetryPolicy struct {
+	// MaxAttempts 는 첫 요청을 포함한 최대 시도 횟수입니다. 1 이하이면 재시도하지 않습니다.
+	MaxAttempts int
+	// BaseDelay 는 첫 재시도 전 대기 시간이며, 시도마다 두 배가 됩니다.
+	BaseDelay time.Duration
+	// MaxDelay 는 재시도 대기 시간의 상한입니다.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy 는 Undetectable 재시작 시간을 고려한 기본 재시도 정책입니다.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: 300 * time.Millisecond, MaxDelay: 3 * time.Second}
+
+// BreakerOptions 는 회로 차단기 설정입니다.
+type BreakerOptions struct {
+	// FailureThreshold 는 차단기를 여는 연속 실패 횟수입니다.
+	FailureThreshold int
+	// Cooldown 은 차단기가 열린 뒤 시험 요청을 허용하기까지의 시간입니다.
+	Cooldown time.Duration
+}
+
+// DefaultBreakerOptions 는 기본 회로 차단기 설정입니다.
+var DefaultBreakerOptions = BreakerOptions{FailureThreshold: 5, Cooldown: 5 * time.Second}
+
+// backoff 는 attempt 번째 재시도 전 대기 시간을 반환합니다. 동시에 실패한 요청들이
+// 한꺼번에 재시도하지 않도록 [d/2, d) 범위의 지터를 줍니다.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << attempt
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// isTransient 는 연결 거부, 연결 끊김처럼 재시도로 해결될 수 있는 오류인지 확인합니다.
+// http.Client 는 모든 오류를 *url.Error 로 감싸므로, 잘못된 URL 이나 지원하지 않는 프로토콜처럼
+// 다시 보내도 같은 오류는 원인을 보고 제외합니다. 호출자가 취소한 요청도 재시도하지 않습니다.
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// circuitBreaker 는 연속 실패 시 브라우저 API 요청을 잠시 막습니다.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	opts     BreakerOptions
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	// 시험 요청이 진행 중인지 여부 (half-open 에서 한 요청만 통과)
+	probing  bool
+	onChange func(BreakerState)
+	logger   *zap.Logger
+}
+
+func newCircuitBreaker(opts BreakerOptions, logger *zap.Logger) *circuitBreaker {
+	return &circuitBreaker{opts: opts, state: BreakerClosed, logger: logger}
+}
+
+// providerTransport 는 공급자 하나의 요청 경로입니다. 재시도 정책은 BrowserManager 것을 쓰고,
+// 회로 차단기는 공급자마다 따로 씁니다.
+type providerTransport struct {
+	bm      *BrowserManager
+	breaker *circuitBreaker
+}
+
+// get 은 ctx 가 취소되면 중단되는 멱등 GET 요청을 보냅니다. 연결 오류는 재시도합니다.
+func (t *providerTransport) get(ctx context.Context, url string) (*http.Response, error) {
+	return t.bm.do(ctx, t.breaker, http.MethodGet, url, nil, true)
+}
+
+// call 은 재시도하지 않는 요청을 보냅니다. 프로필 생성/실행/수정/삭제처럼 두 번 실행되면 안 되는 요청에 사용합니다.
+func (t *providerTransport) call(ctx context.Context, method, url string, body []byte) (*http.Response, error) {
+	return t.bm.do(ctx, t.breaker, method, url, body, false)
+}
+
+// transport 는 name 공급자의 회로 차단기를 쓰는 요청 경로를 반환합니다. 차단기는 공급자마다 한 번 만들어 재사용합니다.
+func (bm *BrowserManager) transport(name string) transport {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	cb, ok := bm.breakers[name]
+	if !ok {
+		cb = newCircuitBreaker(DefaultBreakerOptions, bm.logger.With(zap.String("provider", name)))
+		cb.onChange = func(state BreakerState) { bm.notifyAPIState(name, state) }
+		bm.breakers[name] = cb
+	}
+	return &providerTransport{bm: bm, breaker: cb}
+}
+
+// notifyAPIState 는 현재 공급자의 차단기 상태가 바뀌었을 때만 OnAPIStateChange 함수를 호출합니다.
+func (bm *BrowserManager) notifyAPIState(name string, state BreakerState) {
+	bm.mu.Lock()
+	fn := bm.onAPIState
+	active := bm.provider != nil && bm.provider.Name() == name
+	bm.mu.Unlock()
+	if active && fn != nil {
+		fn(state)
+	}
+}
+
+// allow 는 요청을 보내도 되는지 확인합니다. 차단기가 열려 있으면 APIUnavailableError 를 반환합니다.
+func (cb *circuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case BreakerOpen:
+		wait := cb.opts.Cooldown - time.Since(cb.openedAt)
+		if wait > 0 {
+			return &APIUnavailableError{RetryAfter: wait}
+		}
+		cb.setState(BreakerHalfOpen)
+		cb.probing = true
+		return nil
+	case BreakerHalfOpen:
+		if cb.probing {
+			return &APIUnavailableError{RetryAfter: cb.opts.Cooldown}
+		}
+		cb.probing = true
+	}
+	return nil
+}
+
+// record 는 요청 결과를 반영합니다. failed 는 연결 오류나 5xx 응답일 때 true 입니다.
+func (cb *circuitBreaker) record(failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+	if !failed {
+		cb.failures = 0
+		cb.setState(BreakerClosed)
+		return
+	}
+
+	cb.failures++
+	if cb.state == BreakerHalfOpen || cb.failures >= cb.opts.FailureThreshold {
+		cb.openedAt = time.Now()
+		cb.setState(BreakerOpen)
+	}
+}
+
+// abort 는 결과를 알 수 없이 중단된 요청(호출자 취소)의 시험 요청 표시만 해제합니다.
+func (cb *circuitBreaker) abort() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.probing = false
+}
+
+// setState 는 mu 를 잡은 상태에서 호출해야 합니다.
+func (cb *circuitBreaker) setState(state BreakerState) {
+	if cb.state == state {
+		return
+	}
+	cb.state = state
+	cb.logger.Info("Browser API state changed", zap.String("state", string(state)), zap.Int("failures", cb.failures))
+	if cb.onChange != nil {
+		go cb.onChange(state)
+	}
+}
+
+func (cb *circuitBreaker) current() BreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+// APIState 는 현재 공급자의 회로 차단기 상태를 반환합니다.
+func (bm *BrowserManager) APIState() BreakerState {
+	bm.mu.Lock()
+	cb := bm.breakers[bm.provider.Name()]
+	bm.mu.Unlock()
+	if cb == nil {
+		return BreakerClosed
+	}
+	return cb.current()
+}
+
+// OnAPIStateChange 는 현재 공급자의 회로 차단기 상태가 바뀌거나 공급자가 바뀔 때 호출할 함수를 등록합니다.
+func (bm *BrowserManager) OnAPIStateChange(fn func(BreakerState)) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	bm.onAPIState = fn
+}
+
+// SetRetryPolicy 는 멱등 요청의 재시도 정책을 바꿉니다.
+func (bm *BrowserManager) SetRetryPolicy(p RetryPolicy) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	bm.retry = p
+}
+
+func (bm *BrowserManager) retryPolicy() RetryPolicy {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	return bm.retry
+}
+
+// do 는 공급자의 회로 차단기 cb 를 거쳐 브라우저 API 에 요청을 보냅니다. idempotent 요청은 연결 오류나
+// 5xx 응답에 대해 재시도 정책에 따라 다시 시도합니다. 상태 코드가 5xx 미만이면 응답을 그대로 반환합니다.
+func (bm *BrowserManager) do(ctx context.Context, cb *circuitBreaker, method, url string, body []byte, idempotent bool) (*http.Response, error) {
+	policy := bm.retryPolicy()
+	attempts := 1
+	if idempotent && policy.MaxAttempts > 1 {
+		attempts = policy.MaxAttempts
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(policy.backoff(attempt - 1)):
+			}
+		}
+		if err := cb.allow(); err != nil {
+			return nil, err
+		}
+
+		resp, err := bm.send(ctx, method, url, body)
+		switch {
+		case err == nil && resp.StatusCode < http.StatusInternalServerError:
+			cb.record(false)
+			return resp, nil
+		case err == nil:
+			resp.Body.Close()
+			lastErr = fmt.Errorf("%s %s returned %s", method, url, resp.Status)
+		case isTransient(err):
+			lastErr = err
+		default:
+			cb.abort()
+			return nil, err
+		}
+		cb.record(true)
+	}
+	return nil, &APIUnavailableError{Cause: lastErr}
+}
+
+func (bm *BrowserManager) send(ctx context.Context, method, url string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return http.DefaultClient.Do(req)
+}
@@ -0,0 +1,224 @@
+// internal/browser/retry_test.go
+
+package browser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// testPolicy 는 테스트가 빨리 끝나도록 대기 시간을 짧게 둔 재시도 정책입니다.
+var testPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond}
+
+// statusServer 는 status(n) 이 돌려주는 상태 코드로 응답하고 받은 요청 수를 셉니다.
+func statusServer(t *testing.T, status func(n int) int) (*httptest.Server, *int32) {
+	t.Helper()
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&count, 1))
+		w.WriteHeader(status(n))
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &count
+}
+
+func newTestBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return newCircuitBreaker(BreakerOptions{FailureThreshold: threshold, Cooldown: cooldown}, zap.NewNop())
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"dial refused", &url.Error{Op: "Get", URL: "http://x", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, true},
+		{"connection reset", &url.Error{Op: "Get", URL: "http://x", Err: fmt.Errorf("read: %w", syscall.ECONNRESET)}, true},
+		{"server closed connection", &url.Error{Op: "Get", URL: "http://x", Err: io.EOF}, true},
+		{"unexpected eof", &url.Error{Op: "Get", URL: "http://x", Err: io.ErrUnexpectedEOF}, true},
+		{"unsupported protocol", &url.Error{Op: "Get", URL: "foo://x", Err: errors.New(`unsupported protocol scheme "foo"`)}, false},
+		{"cancelled", &url.Error{Op: "Get", URL: "http://x", Err: context.Canceled}, false},
+		{"deadline", &url.Error{Op: "Get", URL: "http://x", Err: context.DeadlineExceeded}, false},
+		{"plain error", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := isTransient(tt.err); got != tt.want {
+			t.Errorf("%s: isTransient(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestBackoffBounds(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt := 0; attempt < 70; attempt++ {
+		d := p.BaseDelay << attempt
+		if d <= 0 || d > p.MaxDelay {
+			d = p.MaxDelay
+		}
+		for i := 0; i < 20; i++ {
+			if got := p.backoff(attempt); got < d/2 || got >= d {
+				t.Fatalf("backoff(%d) = %s, want in [%s, %s)", attempt, got, d/2, d)
+			}
+		}
+	}
+}
+
+func TestDoRetries5xx(t *testing.T) {
+	srv, count := statusServer(t, func(n int) int {
+		if n < 3 {
+			return http.StatusBadGateway
+		}
+		return http.StatusOK
+	})
+	bm := newTestManager(t, nil)
+	bm.SetRetryPolicy(testPolicy)
+	cb := newTestBreaker(5, time.Minute)
+
+	resp, err := bm.do(context.Background(), cb, http.MethodGet, srv.URL, nil, true)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	resp.Body.Close()
+	if *count != 3 || cb.current() != BreakerClosed || cb.failures != 0 {
+		t.Fatalf("requests = %d, breaker = %s/%d, want 3 requests and a reset closed breaker", *count, cb.current(), cb.failures)
+	}
+}
+
+func TestDoGivesUpAfterMaxAttempts(t *testing.T) {
+	srv, count := statusServer(t, func(int) int { return http.StatusServiceUnavailable })
+	bm := newTestManager(t, nil)
+	bm.SetRetryPolicy(testPolicy)
+	cb := newTestBreaker(5, time.Minute)
+
+	_, err := bm.do(context.Background(), cb, http.MethodGet, srv.URL, nil, true)
+	var unavailable *APIUnavailableError
+	if !errors.As(err, &unavailable) || unavailable.Cause == nil {
+		t.Fatalf("err = %v, want APIUnavailableError with a cause", err)
+	}
+	if int(*count) != testPolicy.MaxAttempts || cb.failures != testPolicy.MaxAttempts {
+		t.Fatalf("requests = %d, failures = %d, want %d", *count, cb.failures, testPolicy.MaxAttempts)
+	}
+}
+
+// 두 번 실행되면 안 되는 요청은 5xx 에도 다시 보내지 않습니다.
+func TestDoDoesNotRetryNonIdempotent(t *testing.T) {
+	srv, count := statusServer(t, func(int) int { return http.StatusInternalServerError })
+	bm := newTestManager(t, nil)
+	bm.SetRetryPolicy(testPolicy)
+	cb := newTestBreaker(5, time.Minute)
+
+	if _, err := bm.do(context.Background(), cb, http.MethodPost, srv.URL, []byte(`{}`), false); !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("err = %v, want ErrAPIUnavailable", err)
+	}
+	if *count != 1 {
+		t.Fatalf("requests = %d, want 1", *count)
+	}
+}
+
+// 연결 오류가 아닌 오류는 재시도하지 않고 차단기 실패로도 세지 않습니다.
+func TestDoDoesNotRetryBadURL(t *testing.T) {
+	bm := newTestManager(t, nil)
+	bm.SetRetryPolicy(testPolicy)
+	cb := newTestBreaker(1, time.Minute)
+
+	_, err := bm.do(context.Background(), cb, http.MethodGet, "foo://127.0.0.1/list", nil, true)
+	if err == nil || errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("err = %v, want the url error itself", err)
+	}
+	if cb.current() != BreakerClosed || cb.failures != 0 {
+		t.Fatalf("breaker = %s/%d after a bad URL, want closed/0", cb.current(), cb.failures)
+	}
+}
+
+func TestDoRetriesConnectionRefused(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	deadURL := dead.URL
+	dead.Close()
+
+	bm := newTestManager(t, nil)
+	bm.SetRetryPolicy(testPolicy)
+	cb := newTestBreaker(5, time.Minute)
+
+	if _, err := bm.do(context.Background(), cb, http.MethodGet, deadURL, nil, true); !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("err = %v, want ErrAPIUnavailable", err)
+	}
+	if cb.failures != testPolicy.MaxAttempts {
+		t.Fatalf("failures = %d, want %d", cb.failures, testPolicy.MaxAttempts)
+	}
+}
+
+// half-open 상태에서는 시험 요청 하나만 보내고, 그 요청이 성공하면 차단기를 닫습니다.
+func TestBreakerHalfOpenSingleProbe(t *testing.T) {
+	fail := int32(1)
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		entered <- struct{}{}
+		<-release
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	bm := newTestManager(t, nil)
+	cb := newTestBreaker(1, 20*time.Millisecond)
+	ctx := context.Background()
+
+	if _, err := bm.do(ctx, cb, http.MethodGet, srv.URL, nil, true); !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("first request err = %v", err)
+	}
+	if cb.current() != BreakerOpen {
+		t.Fatalf("breaker = %s, want open", cb.current())
+	}
+	if _, err := bm.do(ctx, cb, http.MethodGet, srv.URL, nil, true); !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("request while open err = %v, want ErrAPIUnavailable", err)
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Fatalf("requests while open = %d, want 1", got)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	atomic.StoreInt32(&fail, 0)
+	probe := make(chan error, 1)
+	go func() {
+		resp, err := bm.do(ctx, cb, http.MethodGet, srv.URL, nil, true)
+		if err == nil {
+			resp.Body.Close()
+		}
+		probe <- err
+	}()
+	<-entered
+
+	if cb.current() != BreakerHalfOpen {
+		t.Fatalf("breaker during probe = %s, want half-open", cb.current())
+	}
+	var unavailable *APIUnavailableError
+	if _, err := bm.do(ctx, cb, http.MethodGet, srv.URL, nil, true); !errors.As(err, &unavailable) || unavailable.Cause != nil {
+		t.Fatalf("second request during probe err = %v, want rejection without sending", err)
+	}
+	close(release)
+	if err := <-probe; err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	if got := atomic.LoadInt32(&count); got != 2 || cb.current() != BreakerClosed {
+		t.Fatalf("requests = %d, breaker = %s, want 2 and closed", got, cb.current())
+	}
+}
@@ -0,0 +1,56 @@
+// internal/browser/session.go
+
+package browser
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+// trackLaunched 는 이번 세션에서 실행한 프로필 목록을 갱신합니다.
+func (bm *BrowserManager) trackLaunched(profileID string, running bool) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	if running {
+		bm.launched[profileID] = struct{}{}
+	} else {
+		delete(bm.launched, profileID)
+	}
+}
+
+// LaunchedProfiles 는 이번 세션에서 실행했고 아직 종료하지 않은 프로필 ID 를 반환합니다.
+func (bm *BrowserManager) LaunchedProfiles() []string {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	ids := make([]string, 0, len(bm.launched))
+	for id := range bm.launched {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// StopLaunchedProfiles 는 이번 세션에서 실행한 프로필을 모두 종료합니다.
+// ctx 가 만료되면 응답을 기다리지 않고 반환하며, 종료하지 못한 프로필의 오류를 ID 별로 돌려줍니다.
+func (bm *BrowserManager) StopLaunchedProfiles(ctx context.Context) map[string]error {
+	ids := bm.LaunchedProfiles()
+	if len(ids) == 0 {
+		return nil
+	}
+	bm.logger.Info("Stopping launched profiles", zap.Strings("profileIDs", ids))
+
+	errs := make(map[string]error)
+	for _, r := range bm.StopProfiles(ctx, ids, BulkOptions{Concurrency: len(ids)}) {
+		if !r.OK {
+			errs[r.ProfileID] = errors.New(r.Error)
+		}
+	}
+
+	if len(errs) > 0 {
+		bm.logger.Warn("Some profiles could not be stopped", zap.Int("failed", len(errs)))
+	}
+	return errs
+}
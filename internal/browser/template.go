@@ -0,0 +1,361 @@
+// internal/browser/template.go
+
+package browser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cookieBot/utils"
+
+	"go.uber.org/zap"
+)
+
+var (
+	resolutionPattern = regexp.MustCompile(`^\d{3,5}x\d{3,5}$`)
+	languagePattern   = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+	timezonePattern   = regexp.MustCompile(`^(UTC|[A-Za-z]+(/[A-Za-z0-9_+-]+)+)$`)
+)
+
+// NamePlaceholder 는 템플릿 이름 패턴에서 일련번호로 바뀌는 자리입니다. (예: "qa-{n}")
+const NamePlaceholder = "{n}"
+
+// MaxTemplateCount 는 템플릿 하나로 한 번에 만들 수 있는 최대 프로필 수입니다.
+const MaxTemplateCount = 100
+
+// ErrTemplateNotFound 는 이름에 해당하는 템플릿이 없을 때 반환됩니다.
+var ErrTemplateNotFound = errors.New("profile template not found")
+
+// ErrInvalidProfileOption 은 프로필 옵션 값의 형식이 틀리거나 공급자가 받지 않는 값일 때 반환됩니다.
+var ErrInvalidProfileOption = errors.New("invalid profile option")
+
+// ProfileTemplate 은 프로필 생성에 반복해서 쓰는 설정 묶음입니다.
+type ProfileTemplate struct {
+	Name        string               `json:"name"`
+	NamePattern string               `json:"name_pattern"`
+	Profile     CreateProfileRequest `json:"profile"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+// TemplateCreateResult 는 템플릿으로 만든 프로필 하나의 결과입니다.
+type TemplateCreateResult struct {
+	Name      string `json:"name"`
+	ProfileID string `json:"profile_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ValidateProfileOptions 는 CPU, 메모리, 해상도, 언어, 시간대 값의 형식을 확인합니다. 비어 있는 값은 API
+// 기본값을 쓰므로 허용합니다. 공급자가 받는 값인지는 BrowserManager.CheckProfileOptions 가 확인합니다.
+func ValidateProfileOptions(req CreateProfileRequest) error {
+	if req.CPU < 0 {
+		return fmt.Errorf("%w: cpu count %d", ErrInvalidProfileOption, req.CPU)
+	}
+	if req.Memory < 0 {
+		return fmt.Errorf("%w: memory size %d", ErrInvalidProfileOption, req.Memory)
+	}
+	if req.Resolution != "" && !resolutionPattern.MatchString(req.Resolution) {
+		return fmt.Errorf("%w: resolution %q (expected WIDTHxHEIGHT)", ErrInvalidProfileOption, req.Resolution)
+	}
+	if req.Language != "" && !languagePattern.MatchString(req.Language) {
+		return fmt.Errorf("%w: language %q (expected e.g. en-US, zh-Hans)", ErrInvalidProfileOption, req.Language)
+	}
+	if req.Timezone != "" && !timezonePattern.MatchString(req.Timezone) {
+		return fmt.Errorf("%w: timezone %q (expected e.g. Asia/Seoul)", ErrInvalidProfileOption, req.Timezone)
+	}
+	return nil
+}
+
+// ProfileOptions 는 현재 공급자가 받는 옵션 값을 조회합니다. 공급자가 목록을 제공하지 않으면 빈
+// ProfileOptions 를 반환합니다.
+func (bm *BrowserManager) ProfileOptions() (*ProfileOptions, error) {
+	p := bm.Provider()
+	op, ok := p.(OptionsProvider)
+	if !ok {
+		return &ProfileOptions{}, nil
+	}
+	opts, err := op.ProfileOptions(bm.ctx)
+	if err != nil {
+		bm.logger.Error("Failed to get profile options", zap.String("provider", p.Name()), zap.Error(err))
+		return nil, fmt.Errorf("failed to get %s profile options: %w", p.Name(), err)
+	}
+	return opts, nil
+}
+
+// CheckProfileOptions 는 ValidateProfileOptions 의 형식 검사에 더해 OS, 브라우저, CPU, 메모리 값이
+// 현재 공급자가 받는 값인지 확인합니다.
+func (bm *BrowserManager) CheckProfileOptions(req CreateProfileRequest) error {
+	if err := ValidateProfileOptions(req); err != nil {
+		return err
+	}
+	if req.OS == "" && req.Browser == "" && req.CPU == 0 && req.Memory == 0 {
+		return nil
+	}
+	opts, err := bm.ProfileOptions()
+	if err != nil {
+		return err
+	}
+	return checkSupported(req, opts)
+}
+
+// checkSupported 는 req 의 값이 opts 의 목록에 있는지 확인합니다. 비어 있는 값과 목록은 건너뜁니다.
+func checkSupported(req CreateProfileRequest, opts *ProfileOptions) error {
+	if req.OS != "" && len(opts.OS) > 0 && !containsFold(opts.OS, req.OS) {
+		return fmt.Errorf("%w: os %q (supported: %s)", ErrInvalidProfileOption, req.OS, strings.Join(opts.OS, ", "))
+	}
+	if req.Browser != "" && len(opts.Browsers) > 0 && !containsFold(opts.Browsers, req.Browser) {
+		return fmt.Errorf("%w: browser %q (supported: %s)", ErrInvalidProfileOption, req.Browser, strings.Join(opts.Browsers, ", "))
+	}
+	if req.CPU != 0 && len(opts.CPUs) > 0 && !containsInt(opts.CPUs, req.CPU) {
+		return fmt.Errorf("%w: cpu count %d (supported: %s)", ErrInvalidProfileOption, req.CPU, joinInts(opts.CPUs))
+	}
+	if req.Memory != 0 && len(opts.Memory) > 0 && !containsInt(opts.Memory, req.Memory) {
+		return fmt.Errorf("%w: memory size %d (supported: %s)", ErrInvalidProfileOption, req.Memory, joinInts(opts.Memory))
+	}
+	return nil
+}
+
+// Validate 는 템플릿 이름, 이름 패턴, 프로필 옵션을 확인합니다.
+func (t ProfileTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("template name is required")
+	}
+	if t.NamePattern != "" && !strings.Contains(t.NamePattern, NamePlaceholder) {
+		return fmt.Errorf("name pattern must contain %s", NamePlaceholder)
+	}
+	return ValidateProfileOptions(t.Profile)
+}
+
+// TemplateStore 는 프로필 템플릿을 로컬 JSON 파일에 저장합니다.
+type TemplateStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewTemplateStore 는 path 의 파일을 사용하는 템플릿 저장소를 만듭니다. 파일은 첫 저장 시 생성됩니다.
+func NewTemplateStore(path string) *TemplateStore {
+	return &TemplateStore{path: path}
+}
+
+// List 는 모든 템플릿을 이름 순으로 반환합니다.
+func (s *TemplateStore) List() ([]ProfileTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	templates, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ProfileTemplate, 0, len(templates))
+	for _, t := range templates {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// Get 은 이름으로 템플릿을 찾습니다.
+func (s *TemplateStore) Get(name string) (*ProfileTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	templates, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	t, ok := templates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+	return &t, nil
+}
+
+// Save 는 템플릿을 검증한 뒤 같은 이름의 템플릿을 덮어쓰거나 새로 추가합니다.
+func (s *TemplateStore) Save(t ProfileTemplate) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	templates, err := s.load()
+	if err != nil {
+		return err
+	}
+	t.UpdatedAt = time.Now().UTC()
+	templates[t.Name] = t
+	return s.store(templates)
+}
+
+// Delete 는 템플릿을 삭제합니다.
+func (s *TemplateStore) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	templates, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := templates[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+	delete(templates, name)
+	return s.store(templates)
+}
+
+func (s *TemplateStore) load() (map[string]ProfileTemplate, error) {
+	templates := make(map[string]ProfileTemplate)
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return templates, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse templates: %w", err)
+	}
+	return templates, nil
+}
+
+func (s *TemplateStore) store(templates map[string]ProfileTemplate) error {
+	data, err := json.MarshalIndent(templates, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := utils.WriteFileAtomic(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write templates: %w", err)
+	}
+	return nil
+}
+
+// CreateFromTemplate 는 템플릿에 overrides 의 값이 있는 필드를 덮어써 count 개의 프로필을 만듭니다.
+// 이름은 overrides.Name 이 있으면 그것을, 없으면 템플릿 이름 패턴의 {n} 을 기존 프로필과
+// 겹치지 않는 번호로 바꿔 사용합니다.
+func (bm *BrowserManager) CreateFromTemplate(t ProfileTemplate, count int, overrides CreateProfileRequest) ([]TemplateCreateResult, error) {
+	if count < 1 || count > MaxTemplateCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", MaxTemplateCount)
+	}
+
+	req := mergeProfileRequest(t.Profile, overrides)
+	if err := bm.CheckProfileOptions(req); err != nil {
+		return nil, err
+	}
+
+	pattern := t.NamePattern
+	if overrides.Name != "" {
+		pattern = overrides.Name
+	}
+	if pattern == "" {
+		pattern = t.Name + "-" + NamePlaceholder
+	}
+	if count > 1 && !strings.Contains(pattern, NamePlaceholder) {
+		return nil, fmt.Errorf("name pattern must contain %s to create multiple profiles", NamePlaceholder)
+	}
+
+	existing, err := bm.FetchProfiles()
+	if err != nil {
+		return nil, err
+	}
+	taken := make(map[string]bool, len(existing.Data))
+	for _, p := range existing.Data {
+		taken[p.Name] = true
+	}
+
+	bm.logger.Info("Creating profiles from template", zap.String("template", t.Name), zap.Int("count", count))
+
+	results := make([]TemplateCreateResult, 0, count)
+	n := 1
+	for i := 0; i < count; i++ {
+		name := pattern
+		if strings.Contains(pattern, NamePlaceholder) {
+			for ; taken[strings.ReplaceAll(pattern, NamePlaceholder, strconv.Itoa(n))]; n++ {
+			}
+			name = strings.ReplaceAll(pattern, NamePlaceholder, strconv.Itoa(n))
+		}
+		taken[name] = true
+
+		req.Name = name
+		result := TemplateCreateResult{Name: name}
+		resp, err := bm.AddProfile(req)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.ProfileID, _ = resp["profile_id"].(string)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// mergeProfileRequest 는 base 에 overrides 의 비어 있지 않은 필드를 덮어씁니다.
+func mergeProfileRequest(base, overrides CreateProfileRequest) CreateProfileRequest {
+	merged := base
+	setString := func(dst *string, v string) {
+		if v != "" {
+			*dst = v
+		}
+	}
+	setString(&merged.OS, overrides.OS)
+	setString(&merged.Browser, overrides.Browser)
+	setString(&merged.Geolocation, overrides.Geolocation)
+	setString(&merged.Resolution, overrides.Resolution)
+	setString(&merged.Proxy, overrides.Proxy)
+	setString(&merged.Notes, overrides.Notes)
+	setString(&merged.Folder, overrides.Folder)
+	setString(&merged.Language, overrides.Language)
+	setString(&merged.Type, overrides.Type)
+	setString(&merged.Group, overrides.Group)
+	setString(&merged.ConfigID, overrides.ConfigID)
+	setString(&merged.Timezone, overrides.Timezone)
+	if overrides.CPU != 0 {
+		merged.CPU = overrides.CPU
+	}
+	if overrides.Memory != 0 {
+		merged.Memory = overrides.Memory
+	}
+	if overrides.Tags != nil {
+		merged.Tags = overrides.Tags
+	}
+	if overrides.Cookies != nil {
+		merged.Cookies = overrides.Cookies
+	}
+	if overrides.Accounts != nil {
+		merged.Accounts = overrides.Accounts
+	}
+	return merged
+}
+
+func containsFold(values []string, v string) bool {
+	for _, s := range values {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, v int) bool {
+	for _, n := range values {
+		if n == v {
+			return true
+		}
+	}
+	return false
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, n := range values {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ", ")
+}
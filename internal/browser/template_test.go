@@ -0,0 +1,162 @@
+// internal/browser/template_test.go
+
+package browser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// optionsFake 는 ProfileOptions 를 알려 주는 가짜 공급자입니다.
+type optionsFake struct {
+	*fakeProvider
+	opts  ProfileOptions
+	err   error
+	calls int
+}
+
+func (f *optionsFake) ProfileOptions(ctx context.Context) (*ProfileOptions, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	opts := f.opts
+	return &opts, nil
+}
+
+func newOptionsFake(profiles ...Profile) *optionsFake {
+	return &optionsFake{
+		fakeProvider: newFakeProvider("fake", Capabilities{}, profiles...),
+		opts: ProfileOptions{
+			OS:       []string{"Linux", "Windows"},
+			Browsers: []string{"Chrome"},
+			CPUs:     []int{2, 4, 8},
+			Memory:   []int{4, 8},
+		},
+	}
+}
+
+func TestValidateProfileOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		req  CreateProfileRequest
+		ok   bool
+	}{
+		{"empty", CreateProfileRequest{}, true},
+		{"formats", CreateProfileRequest{Resolution: "1920x1080", Language: "ko-KR", Timezone: "Asia/Seoul"}, true},
+		{"script subtag", CreateProfileRequest{Language: "zh-Hans"}, true},
+		{"region number", CreateProfileRequest{Language: "es-419"}, true},
+		{"language only", CreateProfileRequest{Language: "de"}, true},
+		{"negative cpu", CreateProfileRequest{CPU: -1}, false},
+		{"negative memory", CreateProfileRequest{Memory: -4}, false},
+		{"bad resolution", CreateProfileRequest{Resolution: "1920*1080"}, false},
+		{"bad language", CreateProfileRequest{Language: "korean"}, false},
+		{"bad timezone", CreateProfileRequest{Timezone: "Seoul time"}, false},
+	}
+	for _, tt := range tests {
+		err := ValidateProfileOptions(tt.req)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: ValidateProfileOptions(%+v) = %v, want ok %v", tt.name, tt.req, err, tt.ok)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidProfileOption) {
+			t.Errorf("%s: error %v does not wrap ErrInvalidProfileOption", tt.name, err)
+		}
+	}
+}
+
+func TestCheckProfileOptionsUsesProvider(t *testing.T) {
+	fake := newOptionsFake()
+	bm := newTestManager(t, fake)
+
+	tests := []struct {
+		name string
+		req  CreateProfileRequest
+		want string
+	}{
+		{"supported", CreateProfileRequest{OS: "windows", Browser: "chrome", CPU: 4, Memory: 8}, ""},
+		{"os", CreateProfileRequest{OS: "MacOS"}, `os "MacOS"`},
+		{"browser", CreateProfileRequest{Browser: "Firefox"}, `browser "Firefox"`},
+		{"cpu", CreateProfileRequest{CPU: 6}, "cpu count 6"},
+		{"memory", CreateProfileRequest{Memory: 16}, "memory size 16"},
+		{"format checked first", CreateProfileRequest{OS: "Windows", Language: "korean"}, `language "korean"`},
+	}
+	for _, tt := range tests {
+		err := bm.CheckProfileOptions(tt.req)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("%s: CheckProfileOptions = %v, want nil", tt.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidProfileOption) || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: CheckProfileOptions = %v, want ErrInvalidProfileOption mentioning %s", tt.name, err, tt.want)
+		}
+	}
+
+	// 목록이 비어 있는 항목은 공급자가 알려 주지 않은 것이므로 검사하지 않습니다.
+	fake.opts.CPUs = nil
+	if err := bm.CheckProfileOptions(CreateProfileRequest{CPU: 6}); err != nil {
+		t.Fatalf("CheckProfileOptions with no cpu list = %v", err)
+	}
+
+	// 검사할 값이 없으면 공급자에 묻지 않습니다.
+	calls := fake.calls
+	if err := bm.CheckProfileOptions(CreateProfileRequest{Name: "x"}); err != nil || fake.calls != calls {
+		t.Fatalf("CheckProfileOptions(empty) = %v after %d calls, want nil without a call", err, fake.calls-calls)
+	}
+
+	fake.err = fmt.Errorf("api down")
+	if err := bm.CheckProfileOptions(CreateProfileRequest{OS: "Windows"}); err == nil || !strings.Contains(err.Error(), "api down") {
+		t.Fatalf("CheckProfileOptions with failing provider = %v", err)
+	}
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	fake := newOptionsFake(Profile{ID: "p1", Name: "qa-1"})
+	bm := newTestManager(t, fake)
+	tmpl := ProfileTemplate{Name: "qa", NamePattern: "qa-{n}", Profile: CreateProfileRequest{OS: "Windows", Browser: "Chrome"}}
+
+	results, err := bm.CreateFromTemplate(tmpl, 2, CreateProfileRequest{})
+	if err != nil {
+		t.Fatalf("CreateFromTemplate: %v", err)
+	}
+	if len(results) != 2 || results[0].Name != "qa-2" || results[1].Name != "qa-3" || results[0].ProfileID == "" || results[1].Error != "" {
+		t.Fatalf("results = %+v, want qa-2 and qa-3 created", results)
+	}
+
+	// 공급자가 받지 않는 값은 아무것도 만들기 전에 거부합니다.
+	created := len(fake.created)
+	if _, err := bm.CreateFromTemplate(tmpl, 1, CreateProfileRequest{Browser: "Firefox"}); !errors.Is(err, ErrInvalidProfileOption) {
+		t.Fatalf("CreateFromTemplate(Firefox) err = %v, want ErrInvalidProfileOption", err)
+	}
+	if len(fake.created) != created {
+		t.Fatalf("created %d profiles for a rejected template", len(fake.created)-created)
+	}
+
+	for _, count := range []int{0, MaxTemplateCount + 1} {
+		if _, err := bm.CreateFromTemplate(tmpl, count, CreateProfileRequest{}); err == nil {
+			t.Fatalf("CreateFromTemplate(count %d) succeeded", count)
+		}
+	}
+}
+
+// 목록을 주지 않는 공급자가 거부한 값은 공급자의 오류가 프로필별 결과로 돌아와야 합니다.
+func TestCreateFromTemplateSurfacesProviderError(t *testing.T) {
+	fake := newFakeProvider("fake", Capabilities{})
+	fake.createErr = func(req CreateProfileRequest) error {
+		return fmt.Errorf("unsupported browser %q", req.Browser)
+	}
+	bm := newTestManager(t, fake)
+	tmpl := ProfileTemplate{Name: "qa", Profile: CreateProfileRequest{Browser: "Brave"}}
+
+	results, err := bm.CreateFromTemplate(tmpl, 1, CreateProfileRequest{})
+	if err != nil {
+		t.Fatalf("CreateFromTemplate: %v", err)
+	}
+	if len(results) != 1 || results[0].ProfileID != "" || !strings.Contains(results[0].Error, `unsupported browser "Brave"`) {
+		t.Fatalf("results = %+v, want the provider error", results)
+	}
+}
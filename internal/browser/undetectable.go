@@ -0,0 +1,151 @@
+// internal/browser/undetectable.go
+
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// undetectable 은 Undetectable 로컬 API (기본 localhost:25325) 공급자입니다.
+type undetectable struct {
+	t       transport
+	baseURL string
+}
+
+func newUndetectable(t transport, baseURL string) *undetectable {
+	return &undetectable{t: t, baseURL: baseURL}
+}
+
+func (u *undetectable) Name() string { return ProviderUndetectable }
+
+func (u *undetectable) Capabilities() Capabilities {
+	return Capabilities{Update: true, Cookies: true, Folders: true, Tags: true, Debugger: true}
+}
+
+func (u *undetectable) List(ctx context.Context) (*ProfileResponse, error) {
+	resp, err := u.t.get(ctx, u.baseURL+"/list")
+	if err != nil {
+		return nil, err
+	}
+	var profileResponse ProfileResponse
+	if err := decodeJSON(resp, &profileResponse, "profile"); err != nil {
+		return nil, err
+	}
+	// 목록 응답은 ID 를 map 키로만 주므로 각 프로필에 채워 둡니다.
+	for id, p := range profileResponse.Data {
+		p.ID = id
+		profileResponse.Data[id] = p
+	}
+	return &profileResponse, nil
+}
+
+func (u *undetectable) Info(ctx context.Context, profileID string) (*ProfileInfoResponse, error) {
+	resp, err := u.t.get(ctx, fmt.Sprintf("%s/profile/getinfo/%s", u.baseURL, profileID))
+	if err != nil {
+		return nil, err
+	}
+	var info ProfileInfoResponse
+	if err := decodeJSON(resp, &info, "profile info"); err != nil {
+		return nil, err
+	}
+	// 없는 프로필이면 status 가 "error" 로 옵니다.
+	if info.Status != "" && info.Status != "success" {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, profileID)
+	}
+	info.Data.ID = profileID
+	return &info, nil
+}
+
+func (u *undetectable) Create(ctx context.Context, req CreateProfileRequest) (map[string]interface{}, error) {
+	return u.send(ctx, http.MethodPost, "/profile/create", req, "create profile")
+}
+
+func (u *undetectable) Update(ctx context.Context, profileID string, body interface{}) (map[string]interface{}, error) {
+	return u.send(ctx, http.MethodPost, "/profile/update/"+profileID, body, "modify profile")
+}
+
+func (u *undetectable) Delete(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	return u.send(ctx, http.MethodGet, "/profile/delete/"+profileID, nil, "remove profile")
+}
+
+func (u *undetectable) Start(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	return u.send(ctx, http.MethodGet, "/profile/start/"+profileID, nil, "launch profile")
+}
+
+// Stop 은 같은 프로필을 두 번 종료해도 문제가 없으므로 재시도하는 GET 으로 보냅니다.
+func (u *undetectable) Stop(ctx context.Context, profileID string) (map[string]interface{}, error) {
+	resp, err := u.t.get(ctx, u.baseURL+"/profile/stop/"+profileID)
+	if err != nil {
+		return nil, err
+	}
+	var response map[string]interface{}
+	if err := decodeJSON(resp, &response, "terminate profile"); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// undetectableConfig 는 /configslist 응답의 설정(지문 구성) 하나입니다.
+type undetectableConfig struct {
+	OS      string `json:"os"`
+	Browser string `json:"browser"`
+}
+
+// ProfileOptions 는 /configslist 의 설정에서 OS 와 브라우저 목록을 모읍니다. 이 API 는 CPU/메모리
+// 목록을 주지 않으므로 그 항목은 비워 둡니다.
+func (u *undetectable) ProfileOptions(ctx context.Context) (*ProfileOptions, error) {
+	resp, err := u.t.get(ctx, u.baseURL+"/configslist")
+	if err != nil {
+		return nil, err
+	}
+	var list struct {
+		Status string                        `json:"status"`
+		Data   map[string]undetectableConfig `json:"data"`
+	}
+	if err := decodeJSON(resp, &list, "config list"); err != nil {
+		return nil, err
+	}
+	if list.Status != "" && list.Status != "success" {
+		return nil, fmt.Errorf("failed to get config list: status %q", list.Status)
+	}
+
+	osSet, browserSet := map[string]bool{}, map[string]bool{}
+	opts := &ProfileOptions{}
+	for _, c := range list.Data {
+		if c.OS != "" && !osSet[c.OS] {
+			osSet[c.OS] = true
+			opts.OS = append(opts.OS, c.OS)
+		}
+		if c.Browser != "" && !browserSet[c.Browser] {
+			browserSet[c.Browser] = true
+			opts.Browsers = append(opts.Browsers, c.Browser)
+		}
+	}
+	sort.Strings(opts.OS)
+	sort.Strings(opts.Browsers)
+	return opts, nil
+}
+
+// send 는 재시도하지 않는 요청을 보내고 응답 map 을 반환합니다. body 가 nil 이면 본문 없이 보냅니다.
+func (u *undetectable) send(ctx context.Context, method, path string, body interface{}, what string) (map[string]interface{}, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return nil, fmt.Errorf("failed to marshal %s request: %w", what, err)
+		}
+	}
+	resp, err := u.t.call(ctx, method, u.baseURL+path, data)
+	if err != nil {
+		return nil, err
+	}
+	var response map[string]interface{}
+	if err := decodeJSON(resp, &response, what); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
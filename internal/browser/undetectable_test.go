@@ -0,0 +1,141 @@
+// internal/browser/undetectable_test.go
+
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakeUndetectable 는 Undetectable 로컬 API 의 엔드포인트를 흉내 냅니다.
+func fakeUndetectable(t *testing.T) (*httptest.Server, *requestLog) {
+	t.Helper()
+	log := &requestLog{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/list", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		w.Write([]byte(`{"code":0,"status":"success","data":{"p1":{"name":"first","status":"Available","folder":"work","tags":["a"]}}}`))
+	})
+	mux.HandleFunc("/profile/getinfo/", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		if r.URL.Path != "/profile/getinfo/p1" {
+			w.Write([]byte(`{"code":1,"status":"error","data":{"error":"profile not found"}}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"status":"success","data":{"name":"first","status":"Started","debug_port":"9222","websocket_link":"ws://127.0.0.1:9222/devtools/browser/x"}}`))
+	})
+	mux.HandleFunc("/profile/start/", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		w.Write([]byte(`{"code":0,"status":"success","data":{"debug_port":"9222","websocket_link":"ws://127.0.0.1:9222/devtools/browser/x"}}`))
+	})
+	mux.HandleFunc("/profile/stop/", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		w.Write([]byte(`{"code":0,"status":"success","data":{}}`))
+	})
+	mux.HandleFunc("/profile/update/", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		w.Write([]byte(`{"code":0,"status":"success","data":{}}`))
+	})
+	mux.HandleFunc("/configslist", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		w.Write([]byte(`{"code":0,"status":"success","data":{"c1":{"os":"Windows","browser":"Chrome"},"c2":{"os":"MacOS","browser":"Chrome"},"c3":{"os":"Windows","browser":"Edge"}}}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, log
+}
+
+// requestLog 는 가짜 서버가 받은 요청을 기록합니다.
+type requestLog struct {
+	mu       sync.Mutex
+	requests []string
+	bodies   []string
+}
+
+func (l *requestLog) add(r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = append(l.requests, r.Method+" "+r.URL.Path)
+	l.bodies = append(l.bodies, string(body))
+}
+
+func (l *requestLog) last() (string, string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.requests) == 0 {
+		return "", ""
+	}
+	return l.requests[len(l.requests)-1], l.bodies[len(l.bodies)-1]
+}
+
+func TestUndetectableEndpoints(t *testing.T) {
+	srv, log := fakeUndetectable(t)
+	bm := newTestManager(t, nil)
+	u := newUndetectable(bm.transport(ProviderUndetectable), srv.URL)
+	ctx := context.Background()
+
+	list, err := u.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	p, ok := list.Data["p1"]
+	if !ok || p.ID != "p1" || p.Name != "first" || p.Folder != "work" {
+		t.Fatalf("List data = %+v, want p1 with its ID filled in", list.Data)
+	}
+
+	info, err := u.Info(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Info: %v", err)
+	}
+	if info.Data.ID != "p1" || info.Data.DebugPort != "9222" {
+		t.Fatalf("Info data = %+v", info.Data)
+	}
+	if _, err := u.Info(ctx, "missing"); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("Info(missing) err = %v, want ErrProfileNotFound", err)
+	}
+
+	if _, err := u.Start(ctx, "p1"); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if req, _ := log.last(); req != "GET /profile/start/p1" {
+		t.Fatalf("Start sent %q", req)
+	}
+	if _, err := u.Stop(ctx, "p1"); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if req, _ := log.last(); req != "GET /profile/stop/p1" {
+		t.Fatalf("Stop sent %q", req)
+	}
+
+	opts, err := u.ProfileOptions(ctx)
+	if err != nil {
+		t.Fatalf("ProfileOptions: %v", err)
+	}
+	if !reflect.DeepEqual(opts, &ProfileOptions{OS: []string{"MacOS", "Windows"}, Browsers: []string{"Chrome", "Edge"}}) {
+		t.Fatalf("ProfileOptions = %+v", opts)
+	}
+
+	folder := "home"
+	if _, err := u.Update(ctx, "p1", ProfileDiff{Folder: &folder}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	req, body := log.last()
+	if req != "POST /profile/update/p1" {
+		t.Fatalf("Update sent %q", req)
+	}
+	var sent map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &sent); err != nil {
+		t.Fatalf("Update body %q: %v", body, err)
+	}
+	if len(sent) != 1 || sent["folder"] != "home" {
+		t.Fatalf("Update body = %v, want only the folder", sent)
+	}
+}
@@ -0,0 +1,134 @@
+// internal/browser/watcher.go
+
+package browser
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 프로필 상태 변경 이벤트 종류
+const (
+	ProfileStarted = "profile:started"
+	ProfileStopped = "profile:stopped"
+	ProfileChanged = "profile:changed"
+)
+
+// 상태 감시 기본값
+const (
+	DefaultWatchInterval   = 2 * time.Second
+	DefaultWatchMaxBackoff = 30 * time.Second
+)
+
+// ProfileEvent 는 감시 중 발견한 프로필 상태 변화입니다.
+type ProfileEvent struct {
+	Type      string   `json:"type"`
+	ProfileID string   `json:"profile_id"`
+	Profile   Profile  `json:"profile"`
+	Previous  *Profile `json:"previous,omitempty"`
+}
+
+// WatchOptions 는 상태 감시 주기와 이벤트 콜백입니다.
+type WatchOptions struct {
+	Interval time.Duration
+	// MaxBackoff 는 API 에 연결할 수 없을 때 재시도 간격의 상한입니다.
+	MaxBackoff time.Duration
+	OnEvent    func(ProfileEvent)
+}
+
+// WatchProfiles 는 ctx 가 취소될 때까지 /list 를 주기적으로 조회하며 Status, DebugPort,
+// WebsocketLink 의 변화를 OnEvent 로 알립니다. 첫 조회 결과는 기준값으로만 사용합니다.
+// API 에 연결할 수 없으면 조회 간격을 MaxBackoff 까지 두 배씩 늘립니다.
+func (bm *BrowserManager) WatchProfiles(ctx context.Context, opts WatchOptions) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	maxBackoff := opts.MaxBackoff
+	if maxBackoff < interval {
+		maxBackoff = DefaultWatchMaxBackoff
+	}
+
+	bm.logger.Info("Starting profile status watcher", zap.Duration("interval", interval))
+
+	var previous map[string]Profile
+	wait := time.Duration(0)
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			bm.logger.Info("Profile status watcher stopped")
+			return
+		case <-time.After(wait):
+		}
+
+		resp, err := bm.listProfiles(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			failures++
+			wait = min(interval<<min(failures, 10), maxBackoff)
+			// 처음 실패했을 때만 기록해 API 가 꺼져 있는 동안 로그가 쌓이지 않게 합니다.
+			if failures == 1 {
+				bm.logger.Warn("Browser API unreachable, backing off", zap.Error(err))
+			}
+			continue
+		}
+		if failures > 0 {
+			bm.logger.Info("Browser API reachable again", zap.Int("failedPolls", failures))
+			failures = 0
+		}
+		wait = interval
+
+		if previous != nil {
+			for _, ev := range diffProfiles(previous, resp.Data) {
+				if ev.Type == ProfileStopped {
+					bm.trackLaunched(ev.ProfileID, false)
+				}
+				if opts.OnEvent != nil {
+					opts.OnEvent(ev)
+				}
+			}
+		}
+		previous = resp.Data
+	}
+}
+
+// diffProfiles 는 두 목록 사이의 실행 상태 변화를 이벤트로 만듭니다.
+func diffProfiles(before, after map[string]Profile) []ProfileEvent {
+	var events []ProfileEvent
+
+	for id, cur := range after {
+		prev, existed := before[id]
+		switch {
+		case !existed:
+			if isRunning(cur) {
+				events = append(events, ProfileEvent{Type: ProfileStarted, ProfileID: id, Profile: cur})
+			}
+		case !isRunning(prev) && isRunning(cur):
+			events = append(events, ProfileEvent{Type: ProfileStarted, ProfileID: id, Profile: cur, Previous: &prev})
+		case isRunning(prev) && !isRunning(cur):
+			events = append(events, ProfileEvent{Type: ProfileStopped, ProfileID: id, Profile: cur, Previous: &prev})
+		case prev.Status != cur.Status || prev.DebugPort != cur.DebugPort || prev.WebsocketLink != cur.WebsocketLink:
+			events = append(events, ProfileEvent{Type: ProfileChanged, ProfileID: id, Profile: cur, Previous: &prev})
+		}
+	}
+
+	// 실행 중에 목록에서 사라진 프로필(삭제 등)은 종료로 봅니다.
+	for id, prev := range before {
+		if _, ok := after[id]; !ok && isRunning(prev) {
+			events = append(events, ProfileEvent{Type: ProfileStopped, ProfileID: id, Profile: prev, Previous: &prev})
+		}
+	}
+
+	return events
+}
+
+// isRunning 은 Undetectable 이 프로필을 실행 중으로 보고하는지 확인합니다.
+func isRunning(p Profile) bool {
+	return strings.EqualFold(p.Status, "Started") || p.WebsocketLink != ""
+}
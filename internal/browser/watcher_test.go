@@ -0,0 +1,197 @@
+// internal/browser/watcher_test.go
+
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDiffProfiles(t *testing.T) {
+	stopped := Profile{ID: "p1", Status: "Available"}
+	running := Profile{ID: "p1", Status: "Started", DebugPort: "9222", WebsocketLink: "ws://127.0.0.1:9222/devtools/browser/a"}
+	moved := Profile{ID: "p1", Status: "Started", DebugPort: "9333", WebsocketLink: "ws://127.0.0.1:9333/devtools/browser/b"}
+	linkOnly := Profile{ID: "p1", Status: "Available", WebsocketLink: "ws://127.0.0.1:9222/devtools/browser/a"}
+	locked := Profile{ID: "p1", Status: "Locked"}
+
+	tests := []struct {
+		name   string
+		before map[string]Profile
+		after  map[string]Profile
+		want   []string
+	}{
+		{"no change", map[string]Profile{"p1": running}, map[string]Profile{"p1": running}, nil},
+		{"started", map[string]Profile{"p1": stopped}, map[string]Profile{"p1": running}, []string{ProfileStarted}},
+		{"stopped", map[string]Profile{"p1": running}, map[string]Profile{"p1": stopped}, []string{ProfileStopped}},
+		{"port changed", map[string]Profile{"p1": running}, map[string]Profile{"p1": moved}, []string{ProfileChanged}},
+		{"status changed while stopped", map[string]Profile{"p1": stopped}, map[string]Profile{"p1": locked}, []string{ProfileChanged}},
+		{"websocket link counts as running", map[string]Profile{"p1": stopped}, map[string]Profile{"p1": linkOnly}, []string{ProfileStarted}},
+		{"added running", map[string]Profile{}, map[string]Profile{"p1": running}, []string{ProfileStarted}},
+		{"added stopped", map[string]Profile{}, map[string]Profile{"p1": stopped}, nil},
+		{"removed running", map[string]Profile{"p1": running}, map[string]Profile{}, []string{ProfileStopped}},
+		{"removed stopped", map[string]Profile{"p1": stopped}, map[string]Profile{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := diffProfiles(tt.before, tt.after)
+			var got []string
+			for _, ev := range events {
+				if ev.ProfileID != "p1" {
+					t.Fatalf("event for %q, want p1", ev.ProfileID)
+				}
+				got = append(got, ev.Type)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("events = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("events = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	// 이전 상태가 있는 이벤트는 Previous 를 채웁니다.
+	events := diffProfiles(map[string]Profile{"p1": running}, map[string]Profile{"p1": moved})
+	if events[0].Previous == nil || events[0].Previous.DebugPort != "9222" || events[0].Profile.DebugPort != "9333" {
+		t.Fatalf("changed event = %+v", events[0])
+	}
+}
+
+// fakeProfileList 는 /list 응답을 테스트가 바꿀 수 있는 가짜 Undetectable 서버입니다.
+type fakeProfileList struct {
+	mu       sync.Mutex
+	profiles map[string]Profile
+	polls    int
+	failNext int
+}
+
+func (f *fakeProfileList) set(profiles map[string]Profile) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.profiles = profiles
+}
+
+func (f *fakeProfileList) pollCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.polls
+}
+
+func (f *fakeProfileList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.polls++
+	if f.failNext > 0 {
+		f.failNext--
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "status": "success", "data": f.profiles})
+}
+
+func TestWatchProfiles(t *testing.T) {
+	list := &fakeProfileList{profiles: map[string]Profile{
+		"p1": {Name: "one", Status: "Available"},
+		"p2": {Name: "two", Status: "Started", DebugPort: "9222"},
+	}}
+	srv := httptest.NewServer(list)
+	defer srv.Close()
+
+	bm := newTestManager(t, nil)
+	bm.SetProvider(newUndetectable(bm.transport(ProviderUndetectable), srv.URL))
+	bm.trackLaunched("p2", true)
+
+	events := make(chan ProfileEvent, 16)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bm.WatchProfiles(ctx, WatchOptions{Interval: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond, OnEvent: func(ev ProfileEvent) {
+			events <- ev
+		}})
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// 첫 조회는 기준값으로만 쓰이므로 이벤트가 없어야 합니다.
+	waitFor(t, func() bool { return list.pollCount() >= 2 })
+	expectEvents(t, events)
+
+	list.set(map[string]Profile{
+		"p1": {Name: "one", Status: "Started", DebugPort: "9333"},
+		"p2": {Name: "two", Status: "Started", DebugPort: "9222"},
+	})
+	expectEvents(t, events, ProfileStarted+" p1")
+
+	// p2 는 실행 중에 사라지고 p3 는 실행 중으로 추가됩니다.
+	list.set(map[string]Profile{
+		"p1": {Name: "one", Status: "Started", DebugPort: "9333"},
+		"p3": {Name: "three", Status: "Started", DebugPort: "9444"},
+	})
+	expectEvents(t, events, ProfileStarted+" p3", ProfileStopped+" p2")
+	if launched := bm.LaunchedProfiles(); len(launched) != 0 {
+		t.Fatalf("LaunchedProfiles = %v, want p2 untracked after it stopped", launched)
+	}
+
+	// API 가 잠시 응답하지 않은 뒤에도 변화가 이어서 보고됩니다.
+	list.mu.Lock()
+	list.failNext = 2
+	list.mu.Unlock()
+	list.set(map[string]Profile{
+		"p1": {Name: "one", Status: "Available"},
+		"p3": {Name: "three", Status: "Started", DebugPort: "9555"},
+	})
+	expectEvents(t, events, ProfileChanged+" p3", ProfileStopped+" p1")
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("WatchProfiles did not return after cancel")
+	}
+}
+
+// expectEvents 는 다음 이벤트들이 want("type id") 와 같은지 순서 없이 확인하고, 그 뒤 추가 이벤트가 없는지 봅니다.
+func expectEvents(t *testing.T, events <-chan ProfileEvent, want ...string) {
+	t.Helper()
+	var got []string
+	for len(got) < len(want) {
+		select {
+		case ev := <-events:
+			got = append(got, ev.Type+" "+ev.ProfileID)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("events = %v, want %v", got, want)
+		}
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("unexpected event %s %s after %v", ev.Type, ev.ProfileID, got)
+	case <-time.After(50 * time.Millisecond):
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("events = %v, want %v", got, want)
+		}
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(2 * time.Second); !cond(); time.Sleep(5 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met in time")
+		}
+	}
+}
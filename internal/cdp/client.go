@@ -0,0 +1,371 @@
+// internal/cdp/client.go
+
+// Package cdp 는 실행 중인 브라우저 프로필에 Chrome DevTools Protocol 로 연결하는 최소한의 클라이언트입니다.
+// QA 테스트 스크립트에서 탭 목록 조회, 탭 열기/닫기, 페이지 이동, 스크린샷, 제목 읽기에 사용합니다.
+package cdp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"cookieBot/internal/browser"
+
+	"go.uber.org/zap"
+)
+
+// ErrNotRunning 은 프로필에 DevTools 연결 정보가 없을 때(실행 중이 아님) 반환됩니다.
+var ErrNotRunning = errors.New("profile is not running or has no DevTools endpoint")
+
+// Target 은 브라우저의 탭/워커 등 DevTools 대상입니다.
+type Target struct {
+	TargetID string `json:"targetId"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	Attached bool   `json:"attached"`
+}
+
+// Error 는 DevTools 가 돌려준 프로토콜 오류입니다.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("cdp error %d: %s", e.Code, e.Message)
+}
+
+type request struct {
+	ID        int64       `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+	SessionID string      `json:"sessionId,omitempty"`
+}
+
+type response struct {
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+}
+
+// Client 는 브라우저 단위 DevTools 연결입니다. 탭별 명령은 Target.attachToTarget 으로 얻은
+// 세션(flatten 모드)을 통해 같은 연결로 보냅니다.
+type Client struct {
+	ws     *wsConn
+	logger *zap.Logger
+	nextID atomic.Int64
+
+	mu       sync.Mutex
+	pending  map[int64]chan response
+	sessions map[string]string // targetID -> sessionID
+	err      error
+	done     chan struct{}
+}
+
+// Attach 는 실행 중인 프로필의 WebsocketLink(없으면 DebugPort)로 연결합니다.
+func Attach(ctx context.Context, p browser.Profile, logger *zap.Logger) (*Client, error) {
+	wsURL := p.WebsocketLink
+	if wsURL == "" {
+		if p.DebugPort == "" {
+			return nil, ErrNotRunning
+		}
+		var err error
+		if wsURL, err = browserWebsocketURL(ctx, "127.0.0.1:"+p.DebugPort); err != nil {
+			return nil, err
+		}
+	}
+	return Dial(ctx, wsURL, logger)
+}
+
+// Dial 은 브라우저 DevTools 웹소켓 주소로 연결합니다.
+func Dial(ctx context.Context, wsURL string, logger *zap.Logger) (*Client, error) {
+	ws, err := dialWebsocket(ctx, wsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to DevTools: %w", err)
+	}
+
+	c := &Client{
+		ws:       ws,
+		logger:   logger,
+		pending:  make(map[int64]chan response),
+		sessions: make(map[string]string),
+		done:     make(chan struct{}),
+	}
+	go c.readLoop()
+
+	logger.Info("Attached to DevTools", zap.String("url", wsURL))
+	return c, nil
+}
+
+// browserWebsocketURL 은 /json/version 에서 브라우저 웹소켓 주소를 읽습니다.
+func browserWebsocketURL(ctx context.Context, hostPort string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://"+hostPort+"/json/version", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to query DevTools version: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var version struct {
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return "", fmt.Errorf("failed to decode DevTools version: %w", err)
+	}
+	if version.WebSocketDebuggerURL == "" {
+		return "", ErrNotRunning
+	}
+	return version.WebSocketDebuggerURL, nil
+}
+
+// Close 는 연결을 닫습니다.
+func (c *Client) Close() error {
+	return c.ws.close()
+}
+
+// Call 은 DevTools 명령을 보내고 결과를 result 로 디코딩합니다. sessionID 가 비어 있으면 브라우저 대상입니다.
+func (c *Client) Call(ctx context.Context, sessionID, method string, params, result interface{}) error {
+	id := c.nextID.Add(1)
+	ch := make(chan response, 1)
+
+	c.mu.Lock()
+	if c.err != nil {
+		err := c.err
+		c.mu.Unlock()
+		return err
+	}
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	payload, err := json.Marshal(request{ID: id, Method: method, Params: params, SessionID: sessionID})
+	if err != nil {
+		return err
+	}
+	if err := c.ws.writeText(payload); err != nil {
+		return fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.Error != nil {
+			return fmt.Errorf("%s: %w", method, resp.Error)
+		}
+		if result != nil && len(resp.Result) > 0 {
+			return json.Unmarshal(resp.Result, result)
+		}
+		return nil
+	case <-c.done:
+		return c.closedErr()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Client) readLoop() {
+	for {
+		data, err := c.ws.readMessage()
+		if err != nil {
+			c.mu.Lock()
+			if isClosedErr(err) {
+				c.err = errors.New("DevTools connection closed")
+			} else {
+				c.err = fmt.Errorf("DevTools connection failed: %w", err)
+			}
+			c.mu.Unlock()
+			close(c.done)
+			return
+		}
+
+		var resp response
+		if err := json.Unmarshal(data, &resp); err != nil || resp.ID == 0 {
+			// 이벤트 메시지는 사용하지 않습니다.
+			continue
+		}
+
+		c.mu.Lock()
+		ch, ok := c.pending[resp.ID]
+		c.mu.Unlock()
+		if ok {
+			ch <- resp
+		}
+	}
+}
+
+func (c *Client) closedErr() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Targets 는 열린 탭(page 대상) 목록을 반환합니다.
+func (c *Client) Targets(ctx context.Context) ([]Target, error) {
+	var result struct {
+		TargetInfos []Target `json:"targetInfos"`
+	}
+	if err := c.Call(ctx, "", "Target.getTargets", nil, &result); err != nil {
+		return nil, err
+	}
+
+	tabs := []Target{}
+	for _, t := range result.TargetInfos {
+		if t.Type == "page" {
+			tabs = append(tabs, t)
+		}
+	}
+	return tabs, nil
+}
+
+// OpenTab 은 url 로 새 탭을 열고 대상 ID 를 반환합니다.
+func (c *Client) OpenTab(ctx context.Context, url string) (string, error) {
+	if url == "" {
+		url = "about:blank"
+	}
+	var result struct {
+		TargetID string `json:"targetId"`
+	}
+	if err := c.Call(ctx, "", "Target.createTarget", map[string]interface{}{"url": url}, &result); err != nil {
+		return "", err
+	}
+	return result.TargetID, nil
+}
+
+// CloseTab 은 탭을 닫습니다.
+func (c *Client) CloseTab(ctx context.Context, targetID string) error {
+	if err := c.Call(ctx, "", "Target.closeTarget", map[string]interface{}{"targetId": targetID}, nil); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	delete(c.sessions, targetID)
+	c.mu.Unlock()
+	return nil
+}
+
+// Navigate 는 탭을 url 로 이동시킵니다. 페이지 로딩 완료는 기다리지 않습니다.
+func (c *Client) Navigate(ctx context.Context, targetID, url string) error {
+	sessionID, err := c.session(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ErrorText string `json:"errorText"`
+	}
+	if err := c.Call(ctx, sessionID, "Page.navigate", map[string]interface{}{"url": url}, &result); err != nil {
+		return err
+	}
+	if result.ErrorText != "" {
+		return fmt.Errorf("navigation to %s failed: %s", url, result.ErrorText)
+	}
+	return nil
+}
+
+// Screenshot 은 탭의 현재 화면을 PNG 로 반환합니다.
+func (c *Client) Screenshot(ctx context.Context, targetID string) ([]byte, error) {
+	sessionID, err := c.session(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Data string `json:"data"`
+	}
+	if err := c.Call(ctx, sessionID, "Page.captureScreenshot", map[string]interface{}{"format": "png"}, &result); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(result.Data)
+}
+
+// Title 은 탭의 document.title 을 반환합니다.
+func (c *Client) Title(ctx context.Context, targetID string) (string, error) {
+	sessionID, err := c.session(ctx, targetID)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		Result struct {
+			Value string `json:"value"`
+		} `json:"result"`
+	}
+	params := map[string]interface{}{"expression": "document.title", "returnByValue": true}
+	if err := c.Call(ctx, sessionID, "Runtime.evaluate", params, &result); err != nil {
+		return "", err
+	}
+	return result.Result.Value, nil
+}
+
+// session 은 탭에 연결된 세션 ID 를 반환하며, 없으면 새로 연결합니다.
+func (c *Client) session(ctx context.Context, targetID string) (string, error) {
+	c.mu.Lock()
+	sessionID, ok := c.sessions[targetID]
+	c.mu.Unlock()
+	if ok {
+		return sessionID, nil
+	}
+
+	var result struct {
+		SessionID string `json:"sessionId"`
+	}
+	params := map[string]interface{}{"targetId": targetID, "flatten": true}
+	if err := c.Call(ctx, "", "Target.attachToTarget", params, &result); err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.sessions[targetID] = result.SessionID
+	c.mu.Unlock()
+	return result.SessionID, nil
+}
+
+// Cookies 는 브라우저의 모든 쿠키를 프로필 쿠키 형식으로 반환합니다.
+func (c *Client) Cookies(ctx context.Context) ([]browser.Cookie, error) {
+	var result struct {
+		Cookies []struct {
+			Name     string  `json:"name"`
+			Value    string  `json:"value"`
+			Domain   string  `json:"domain"`
+			Path     string  `json:"path"`
+			Expires  float64 `json:"expires"`
+			HTTPOnly bool    `json:"httpOnly"`
+			Secure   bool    `json:"secure"`
+			Session  bool    `json:"session"`
+			SameSite string  `json:"sameSite"`
+		} `json:"cookies"`
+	}
+	if err := c.Call(ctx, "", "Storage.getCookies", nil, &result); err != nil {
+		return nil, err
+	}
+
+	cookies := make([]browser.Cookie, 0, len(result.Cookies))
+	for _, ck := range result.Cookies {
+		cookie := browser.Cookie{
+			Name:     ck.Name,
+			Value:    ck.Value,
+			Domain:   ck.Domain,
+			Path:     ck.Path,
+			HostOnly: !strings.HasPrefix(ck.Domain, "."),
+			HTTPOnly: ck.HTTPOnly,
+			Secure:   ck.Secure,
+			Session:  ck.Session,
+			SameSite: ck.SameSite,
+		}
+		if !ck.Session && ck.Expires > 0 {
+			cookie.ExpirationDate = ck.Expires
+		}
+		cookies = append(cookies, cookie)
+	}
+	return browser.NormalizeCookies(cookies)
+}
@@ -0,0 +1,373 @@
+// internal/cdp/client_test.go
+
+package cdp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cookieBot/internal/browser"
+
+	"go.uber.org/zap"
+)
+
+// fakeDevTools 는 웹소켓 업그레이드를 직접 처리하는 가짜 DevTools 서버입니다.
+// 응답 앞에는 이벤트와 ping 을 끼워 보내고, 큰 응답은 여러 프레임으로 나눠 보냅니다.
+type fakeDevTools struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	methods  []string
+	closed   bool // 클라이언트가 close 프레임을 보냈는지
+	pongs    int
+	screen   []byte
+	origins  []string
+	closeNow chan struct{}
+}
+
+func newFakeDevTools(t *testing.T) *fakeDevTools {
+	t.Helper()
+	f := &fakeDevTools{t: t, screen: []byte(strings.Repeat("png", 100)), closeNow: make(chan struct{})}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"Browser":"Chrome/126","webSocketDebuggerUrl":%q}`, f.wsURL())
+	})
+	mux.HandleFunc("/devtools/browser/x", f.serveWebsocket)
+	f.srv = httptest.NewServer(mux)
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+func (f *fakeDevTools) wsURL() string {
+	return "ws://" + f.srv.Listener.Addr().String() + "/devtools/browser/x"
+}
+
+func (f *fakeDevTools) port() string {
+	_, port, _ := net.SplitHostPort(f.srv.Listener.Addr().String())
+	return port
+}
+
+func (f *fakeDevTools) calls(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, m := range f.methods {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeDevTools) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.origins = append(f.origins, r.Header.Get("Origin"))
+	f.mu.Unlock()
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") || r.Header.Get("Sec-WebSocket-Key") == "" {
+		http.Error(w, "not a websocket request", http.StatusBadRequest)
+		return
+	}
+	conn, rw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		f.t.Errorf("Hijack: %v", err)
+		return
+	}
+	defer conn.Close()
+	fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n",
+		acceptKey(r.Header.Get("Sec-WebSocket-Key")))
+	rw.Flush()
+
+	ws := &wsConn{conn: conn, br: rw.Reader}
+	frames := make(chan []byte)
+	go func() {
+		defer close(frames)
+		for {
+			_, op, payload, err := ws.readFrame()
+			if err != nil {
+				return
+			}
+			switch op {
+			case opClose:
+				f.mu.Lock()
+				f.closed = true
+				f.mu.Unlock()
+				return
+			case opPong:
+				f.mu.Lock()
+				f.pongs++
+				f.mu.Unlock()
+				continue
+			}
+			frames <- payload
+		}
+	}()
+
+	for {
+		select {
+		case <-f.closeNow:
+			writeServerFrame(conn, true, opClose, nil)
+			return
+		case payload, ok := <-frames:
+			if !ok {
+				return
+			}
+			f.reply(conn, payload)
+		}
+	}
+}
+
+func (f *fakeDevTools) reply(conn net.Conn, payload []byte) {
+	var req request
+	if err := json.Unmarshal(payload, &req); err != nil {
+		writeServerFrame(conn, true, opClose, nil)
+		return
+	}
+	f.mu.Lock()
+	f.methods = append(f.methods, req.Method)
+	f.mu.Unlock()
+
+	// 응답보다 먼저 오는 이벤트와 ping 은 대기 중인 호출을 방해하지 않아야 합니다.
+	writeServerFrame(conn, true, opText, []byte(`{"method":"Target.targetInfoChanged","params":{"targetInfo":{"targetId":"t1"}}}`))
+	writeServerFrame(conn, true, opPing, []byte("hi"))
+
+	resp := map[string]interface{}{"id": req.ID}
+	switch {
+	case req.Method == "Target.getTargets":
+		resp["result"] = map[string]interface{}{"targetInfos": []Target{
+			{TargetID: "t1", Type: "page", Title: "Example", URL: "https://example.com"},
+			{TargetID: "w1", Type: "service_worker"},
+		}}
+	case req.Method == "Target.attachToTarget":
+		resp["result"] = map[string]interface{}{"sessionId": "s1"}
+	case req.Method == "Runtime.evaluate" && req.SessionID == "s1":
+		resp["result"] = map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "Example Domain"}}
+	case req.Method == "Page.captureScreenshot" && req.SessionID == "s1":
+		resp["result"] = map[string]interface{}{"data": base64.StdEncoding.EncodeToString(f.screen)}
+		data, _ := json.Marshal(resp)
+		// 126 바이트가 넘는 메시지를 continuation 프레임으로 나눠 보냅니다.
+		half := len(data) / 2
+		writeServerFrame(conn, false, opText, data[:half])
+		writeServerFrame(conn, true, opContinuation, data[half:])
+		return
+	default:
+		resp["error"] = Error{Code: -32601, Message: fmt.Sprintf("'%s' wasn't found", req.Method)}
+	}
+	data, _ := json.Marshal(resp)
+	writeServerFrame(conn, true, opText, data)
+}
+
+// writeServerFrame 은 서버 쪽 프레임(마스킹 없음)을 씁니다.
+func writeServerFrame(w io.Writer, fin bool, op byte, payload []byte) {
+	first := op
+	if fin {
+		first |= 0x80
+	}
+	header := []byte{first}
+	switch n := len(payload); {
+	case n < 126:
+		header = append(header, byte(n))
+	case n <= 0xFFFF:
+		header = append(header, 126, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+	default:
+		header = append(header, 127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+	}
+	w.Write(append(header, payload...))
+}
+
+func dialFake(t *testing.T, f *fakeDevTools) *Client {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := Dial(ctx, f.wsURL(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestClientRuntimeEvaluate(t *testing.T) {
+	f := newFakeDevTools(t)
+	c := dialFake(t, f)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 2; i++ {
+		title, err := c.Title(ctx, "t1")
+		if err != nil {
+			t.Fatalf("Title: %v", err)
+		}
+		if title != "Example Domain" {
+			t.Fatalf("Title = %q, want %q", title, "Example Domain")
+		}
+	}
+	if n := f.calls("Target.attachToTarget"); n != 1 {
+		t.Fatalf("attachToTarget called %d times, want 1 (session reused)", n)
+	}
+
+	var raw struct {
+		Result struct {
+			Value string `json:"value"`
+		} `json:"result"`
+	}
+	params := map[string]interface{}{"expression": "document.title", "returnByValue": true}
+	if err := c.Call(ctx, "", "Runtime.evaluate", params, &raw); err == nil {
+		t.Fatalf("Runtime.evaluate without a session succeeded")
+	}
+	var cdpErr *Error
+	if err := c.Call(ctx, "", "Unknown.method", nil, nil); !errors.As(err, &cdpErr) || cdpErr.Code != -32601 {
+		t.Fatalf("Call(Unknown.method) err = %v, want a protocol error", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.origins) != 1 || f.origins[0] != "" {
+		t.Fatalf("handshake Origin headers = %q, want none", f.origins)
+	}
+	if f.pongs == 0 {
+		t.Fatalf("client did not answer pings")
+	}
+}
+
+func TestClientTargetsAndFragmentedMessages(t *testing.T) {
+	f := newFakeDevTools(t)
+	c := dialFake(t, f)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tabs, err := c.Targets(ctx)
+	if err != nil {
+		t.Fatalf("Targets: %v", err)
+	}
+	if len(tabs) != 1 || tabs[0].TargetID != "t1" {
+		t.Fatalf("Targets = %+v, want only the page target", tabs)
+	}
+
+	png, err := c.Screenshot(ctx, "t1")
+	if err != nil {
+		t.Fatalf("Screenshot: %v", err)
+	}
+	if string(png) != string(f.screen) {
+		t.Fatalf("Screenshot returned %d bytes, want %d", len(png), len(f.screen))
+	}
+}
+
+// 동시에 보낸 호출도 각자의 응답을 받아야 합니다.
+func TestClientConcurrentCalls(t *testing.T) {
+	f := newFakeDevTools(t)
+	c := dialFake(t, f)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Targets(ctx); err != nil {
+				t.Errorf("Targets: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientServerClose(t *testing.T) {
+	f := newFakeDevTools(t)
+	c := dialFake(t, f)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	close(f.closeNow)
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		t.Fatalf("client did not notice the close frame")
+	}
+	if _, err := c.Targets(ctx); err == nil || !strings.Contains(err.Error(), "closed") {
+		t.Fatalf("Targets after close err = %v, want connection closed", err)
+	}
+}
+
+func TestClientClose(t *testing.T) {
+	f := newFakeDevTools(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := Dial(ctx, f.wsURL(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		t.Fatalf("read loop did not stop after Close")
+	}
+	for deadline := time.Now().Add(2 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		f.mu.Lock()
+		closed := f.closed
+		f.mu.Unlock()
+		if closed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server did not receive a close frame")
+		}
+	}
+}
+
+func TestAttachUsesDebugPort(t *testing.T) {
+	f := newFakeDevTools(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := Attach(ctx, browser.Profile{ID: "p1"}, zap.NewNop()); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("Attach without endpoint err = %v, want ErrNotRunning", err)
+	}
+
+	c, err := Attach(ctx, browser.Profile{ID: "p1", DebugPort: f.port()}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	defer c.Close()
+	if _, err := c.Targets(ctx); err != nil {
+		t.Fatalf("Targets: %v", err)
+	}
+}
+
+func TestDialRejectsBadHandshake(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, rw, _ := w.(http.Hijacker).Hijack()
+		defer conn.Close()
+		rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: wrong\r\n\r\n")
+		rw.Flush()
+	}))
+	defer srv.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+	if _, err := Dial(ctx, wsURL, zap.NewNop()); err == nil || !strings.Contains(err.Error(), "accept key") {
+		t.Fatalf("Dial err = %v, want invalid accept key", err)
+	}
+	if _, err := Dial(ctx, "wss://127.0.0.1/devtools", zap.NewNop()); err == nil {
+		t.Fatalf("Dial accepted a wss:// url")
+	}
+}
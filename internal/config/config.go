@@ -6,13 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+
+	"cookieBot/internal/secrets"
 )
 
 type Config struct {
 	AWS struct {
-		Region          string `json:"Region"`
+		Region string `json:"Region"`
+		// AccessKeyID, SecretAccessKey 에는 값 대신 비밀 저장소 참조("secret://aws/default")를 쓸 수 있습니다.
 		AccessKeyID     string `json:"AccessKeyID"`
 		SecretAccessKey string `json:"SecretAccessKey"`
+		// Endpoint 는 DynamoDB 엔드포인트를 바꿉니다. DynamoDB Local 은 "http://localhost:8000".
+		Endpoint string `json:"Endpoint,omitempty"`
 	} `json:"AWS"`
 	TableName string `json:"TableName"`
 }
@@ -32,3 +37,14 @@ func LoadConfig(configFile string) (*Config, error) {
 
 	return config, nil
 }
+
+// AWSCredentials 는 AWS 키를 반환합니다. "secret://" 참조는 store 에서 꺼냅니다.
+func (c *Config) AWSCredentials(store secrets.Store) (accessKeyID, secretAccessKey string, err error) {
+	if accessKeyID, err = secrets.Resolve(store, c.AWS.AccessKeyID); err != nil {
+		return "", "", err
+	}
+	if secretAccessKey, err = secrets.Resolve(store, c.AWS.SecretAccessKey); err != nil {
+		return "", "", err
+	}
+	return accessKeyID, secretAccessKey, nil
+}
@@ -4,9 +4,14 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
+	"cookieBot/internal/audit"
 	awsCfg "cookieBot/internal/config"
+	"cookieBot/internal/secrets"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -20,47 +25,210 @@ type GmailAccount struct {
 	Password      string
 	RecoveryEmail string
 	Used          bool
+	// Version 은 저장할 때마다 1씩 증가합니다. 수정 시 읽었던 값과 다르면 ConflictError 가 반환됩니다.
+	// 버전 관리 이전에 저장된 계정은 0 입니다.
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
+// MaskedPassword 는 목록 등에서 비밀번호 대신 보여 주는 값입니다.
+const MaskedPassword = "********"
+
+// MaskPassword 는 화면이나 출력으로 내보내기 전에 비밀번호를 가립니다.
+func (a *GmailAccount) MaskPassword() {
+	if a.Password != "" {
+		a.Password = MaskedPassword
+	}
+}
+
+// ErrConflict 는 errors.Is 로 동시 수정 충돌을 확인할 때 사용합니다.
+var ErrConflict = errors.New("account was modified by someone else")
+
+// ErrAccountNotFound 는 이메일에 해당하는 계정이 없을 때 반환됩니다.
+var ErrAccountNotFound = errors.New("account not found")
+
+// ConflictError 는 조건부 쓰기가 실패했을 때 반환됩니다. UI 는 계정을 다시 불러온 뒤 재시도하면 됩니다.
+type ConflictError struct {
+	Email string
+	// Create 는 생성 요청에서 발생한 충돌인지 여부입니다.
+	Create bool
+	// Exists 는 저장소에 해당 계정이 있는지 여부입니다.
+	Exists bool
+	// Expected 는 수정 요청의 버전, Current 는 저장소의 현재 버전입니다.
+	Expected int64
+	Current  int64
+}
+
+func (e *ConflictError) Error() string {
+	switch {
+	case e.Create:
+		return fmt.Sprintf("conflict: account %s already exists", e.Email)
+	case !e.Exists:
+		return fmt.Sprintf("conflict: account %s no longer exists", e.Email)
+	default:
+		return fmt.Sprintf("conflict: account %s was modified by someone else (version %d, expected %d); reload and try again", e.Email, e.Current, e.Expected)
+	}
+}
+
+func (e *ConflictError) Is(target error) bool { return target == ErrConflict }
+
 type EmailDB struct {
 	client    *dynamodb.Client
 	tableName string
+	audit     *audit.Log
 }
 
-// NewEmailDB initializes a new EmailDB instance
-func NewEmailDB(configFile string) (*EmailDB, error) {
+// NewEmailDB initializes a new EmailDB instance. "secret://" references in the AWS keys are resolved
+// from store on the first request, so the store may still be locked when this is called.
+func NewEmailDB(configFile string, store secrets.Store) (*EmailDB, error) {
 	cfg, err := awsCfg.LoadConfig(configFile)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load config: %w", err)
 	}
 
+	creds := aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		accessKeyID, secretAccessKey, err := cfg.AWSCredentials(store)
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+		return credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, "").Retrieve(ctx)
+	})
+
 	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithRegion(cfg.AWS.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cfg.AWS.AccessKeyID, cfg.AWS.SecretAccessKey, "")),
+		config.WithCredentialsProvider(aws.NewCredentialsCache(creds)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load AWS SDK config: %w", err)
 	}
 
-	client := dynamodb.NewFromConfig(awsCfg)
+	client := dynamodb.NewFromConfig(awsCfg, func(o *dynamodb.Options) {
+		if cfg.AWS.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.AWS.Endpoint)
+		}
+	})
 	return &EmailDB{client: client, tableName: cfg.TableName}, nil
 }
 
-// SaveEmail saves a GmailAccount to the DynamoDB table
-func (db *EmailDB) SaveEmail(email GmailAccount) error {
-	item := map[string]types.AttributeValue{
+// SetAuditLog sets the audit log that records account and table changes.
+func (db *EmailDB) SetAuditLog(l *audit.Log) {
+	db.audit = l
+}
+
+// CreateEmail adds a new GmailAccount. It fails with a ConflictError if the email already exists.
+func (db *EmailDB) CreateEmail(email GmailAccount) (_ *GmailAccount, err error) {
+	defer func() {
+		db.audit.Record("account.create", email.Email, map[string]interface{}{"used": email.Used}, err)
+	}()
+
+	now := time.Now().UTC()
+	email.Version = 1
+	email.CreatedAt = now
+	email.UpdatedAt = now
+
+	_, err = db.client.PutItem(context.TODO(), &dynamodb.PutItemInput{
+		TableName:                           aws.String(db.tableName),
+		Item:                                accountItem(email),
+		ConditionExpression:                 aws.String("attribute_not_exists(email)"),
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	})
+	if err != nil {
+		return nil, conflictError(err, &ConflictError{Email: email.Email, Create: true})
+	}
+	return &email, nil
+}
+
+// UpdateEmail updates an existing GmailAccount if its stored version still matches email.Version.
+// The returned account carries the new version.
+func (db *EmailDB) UpdateEmail(email GmailAccount) (_ *GmailAccount, err error) {
+	defer func() {
+		db.audit.Record("account.update", email.Email, map[string]interface{}{"used": email.Used, "version": email.Version}, err)
+	}()
+
+	now := time.Now().UTC()
+	values := map[string]types.AttributeValue{
+		":password":       &types.AttributeValueMemberS{Value: email.Password},
+		":recovery_email": &types.AttributeValueMemberS{Value: email.RecoveryEmail},
+		":used":           &types.AttributeValueMemberBOOL{Value: email.Used},
+		":next":           &types.AttributeValueMemberN{Value: strconv.FormatInt(email.Version+1, 10)},
+		":now":            &types.AttributeValueMemberS{Value: now.Format(time.RFC3339Nano)},
+	}
+	// 버전 관리 이전 계정(version 없음)은 Version 0 으로 수정할 수 있습니다.
+	condition := "attribute_exists(email) AND attribute_not_exists(version)"
+	if email.Version > 0 {
+		condition = "version = :expected"
+		values[":expected"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(email.Version, 10)}
+	}
+
+	result, err := db.client.UpdateItem(context.TODO(), &dynamodb.UpdateItemInput{
+		TableName: aws.String(db.tableName),
+		Key: map[string]types.AttributeValue{
+			"email": &types.AttributeValueMemberS{Value: email.Email},
+		},
+		UpdateExpression: aws.String("SET password = :password, recovery_email = :recovery_email, used = :used, " +
+			"version = :next, updated_at = :now, created_at = if_not_exists(created_at, :now)"),
+		ConditionExpression:                 aws.String(condition),
+		ExpressionAttributeValues:           values,
+		ReturnValues:                        types.ReturnValueAllNew,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	})
+	if err != nil {
+		return nil, conflictError(err, &ConflictError{Email: email.Email, Expected: email.Version})
+	}
+	return accountFromItem(result.Attributes), nil
+}
+
+// conflictError 는 조건부 쓰기 실패를 저장소의 현재 상태를 채운 conflict 로 바꿉니다. 다른 오류는 그대로 반환합니다.
+func conflictError(err error, conflict *ConflictError) error {
+	var ccf *types.ConditionalCheckFailedException
+	if !errors.As(err, &ccf) {
+		return err
+	}
+	conflict.Exists = len(ccf.Item) > 0
+	if conflict.Exists {
+		conflict.Current = accountFromItem(ccf.Item).Version
+	}
+	return conflict
+}
+
+func accountItem(email GmailAccount) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
 		"email":          &types.AttributeValueMemberS{Value: email.Email},
 		"password":       &types.AttributeValueMemberS{Value: email.Password},
 		"recovery_email": &types.AttributeValueMemberS{Value: email.RecoveryEmail},
 		"used":           &types.AttributeValueMemberBOOL{Value: email.Used},
+		"version":        &types.AttributeValueMemberN{Value: strconv.FormatInt(email.Version, 10)},
+		"created_at":     &types.AttributeValueMemberS{Value: email.CreatedAt.Format(time.RFC3339Nano)},
+		"updated_at":     &types.AttributeValueMemberS{Value: email.UpdatedAt.Format(time.RFC3339Nano)},
 	}
+}
 
-	_, err := db.client.PutItem(context.TODO(), &dynamodb.PutItemInput{
-		TableName: aws.String(db.tableName),
-		Item:      item,
-	})
-
-	return err
+// accountFromItem 은 DynamoDB 항목을 GmailAccount 로 바꿉니다. 버전 관리 이전 항목처럼 없는 속성은 빈 값으로 둡니다.
+func accountFromItem(item map[string]types.AttributeValue) *GmailAccount {
+	account := &GmailAccount{}
+	if v, ok := item["email"].(*types.AttributeValueMemberS); ok {
+		account.Email = v.Value
+	}
+	if v, ok := item["password"].(*types.AttributeValueMemberS); ok {
+		account.Password = v.Value
+	}
+	if v, ok := item["recovery_email"].(*types.AttributeValueMemberS); ok {
+		account.RecoveryEmail = v.Value
+	}
+	if v, ok := item["used"].(*types.AttributeValueMemberBOOL); ok {
+		account.Used = v.Value
+	}
+	if v, ok := item["version"].(*types.AttributeValueMemberN); ok {
+		account.Version, _ = strconv.ParseInt(v.Value, 10, 64)
+	}
+	if v, ok := item["created_at"].(*types.AttributeValueMemberS); ok {
+		account.CreatedAt, _ = time.Parse(time.RFC3339Nano, v.Value)
+	}
+	if v, ok := item["updated_at"].(*types.AttributeValueMemberS); ok {
+		account.UpdatedAt, _ = time.Parse(time.RFC3339Nano, v.Value)
+	}
+	return account
 }
 
 // GetEmail retrieves a GmailAccount from the DynamoDB table by email
@@ -77,39 +245,23 @@ func (db *EmailDB) GetEmail(email string) (*GmailAccount, error) {
 	}
 
 	if result.Item == nil {
-		return nil, fmt.Errorf("could not find email with address %s", email)
+		return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, email)
 	}
 
-	account := &GmailAccount{
-		Email:         result.Item["email"].(*types.AttributeValueMemberS).Value,
-		Password:      result.Item["password"].(*types.AttributeValueMemberS).Value,
-		RecoveryEmail: result.Item["recovery_email"].(*types.AttributeValueMemberS).Value,
-		Used:          result.Item["used"].(*types.AttributeValueMemberBOOL).Value,
-	}
-
-	return account, nil
+	return accountFromItem(result.Item), nil
 }
 
 // ListEmails lists all GmailAccounts from the DynamoDB table
 func (db *EmailDB) ListEmails() ([]GmailAccount, error) {
-	input := &dynamodb.ScanInput{
-		TableName: aws.String(db.tableName),
-	}
-
-	result, err := db.client.Scan(context.TODO(), input)
-	if err != nil {
-		return nil, err
-	}
-
 	var accounts []GmailAccount
-	for _, item := range result.Items {
-		account := GmailAccount{
-			Email:         item["email"].(*types.AttributeValueMemberS).Value,
-			Password:      item["password"].(*types.AttributeValueMemberS).Value,
-			RecoveryEmail: item["recovery_email"].(*types.AttributeValueMemberS).Value,
-			Used:          item["used"].(*types.AttributeValueMemberBOOL).Value,
+	err := db.scanPages(context.TODO(), 0, nil, func(items []map[string]types.AttributeValue, _ map[string]types.AttributeValue) error {
+		for _, item := range items {
+			accounts = append(accounts, *accountFromItem(item))
 		}
-		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return accounts, nil
@@ -124,13 +276,13 @@ func (db *EmailDB) DeleteEmail(email string) error {
 		},
 	})
 
+	db.audit.Record("account.delete", email, nil, err)
 	return err
 }
 
 func (db *EmailDB) BulkInsertEmails(accounts []GmailAccount) error {
 	for _, account := range accounts {
-		err := db.SaveEmail(account)
-		if err != nil {
+		if _, err := db.CreateEmail(account); err != nil {
 			return fmt.Errorf("failed to insert email %s: %w", account.Email, err)
 		}
 	}
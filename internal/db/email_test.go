@@ -0,0 +1,96 @@
+// internal/db/email_test.go
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestCreateEmailConflict(t *testing.T) {
+	db := newLocalTable(t)
+	account := GmailAccount{Email: "a@example.com", Password: "pw"}
+
+	created, err := db.CreateEmail(account)
+	if err != nil {
+		t.Fatalf("CreateEmail: %v", err)
+	}
+	if created.Version != 1 || created.CreatedAt.IsZero() {
+		t.Fatalf("created = %+v, want version 1 with timestamps", created)
+	}
+
+	_, err = db.CreateEmail(account)
+	var conflict *ConflictError
+	if !errors.Is(err, ErrConflict) || !errors.As(err, &conflict) || !conflict.Create || !conflict.Exists || conflict.Current != 1 {
+		t.Fatalf("second CreateEmail err = %#v, want a create conflict", err)
+	}
+}
+
+func TestUpdateEmailConflict(t *testing.T) {
+	db := newLocalTable(t)
+	created, err := db.CreateEmail(GmailAccount{Email: "a@example.com", Password: "pw"})
+	if err != nil {
+		t.Fatalf("CreateEmail: %v", err)
+	}
+
+	stale := *created
+	created.Used = true
+	updated, err := db.UpdateEmail(*created)
+	if err != nil {
+		t.Fatalf("UpdateEmail: %v", err)
+	}
+	if updated.Version != 2 || !updated.Used {
+		t.Fatalf("updated = %+v, want version 2 and used", updated)
+	}
+
+	// 다른 사용자가 먼저 수정한 뒤 예전 버전으로 저장하면 충돌입니다.
+	stale.Password = "other"
+	_, err = db.UpdateEmail(stale)
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || conflict.Create || !conflict.Exists || conflict.Expected != 1 || conflict.Current != 2 {
+		t.Fatalf("stale UpdateEmail err = %#v, want version conflict 1 vs 2", err)
+	}
+	if current, err := db.GetEmail(created.Email); err != nil || current.Password != "pw" {
+		t.Fatalf("GetEmail after conflict = %+v, %v; want the first update kept", current, err)
+	}
+
+	if err := db.DeleteEmail(created.Email); err != nil {
+		t.Fatalf("DeleteEmail: %v", err)
+	}
+	_, err = db.UpdateEmail(*updated)
+	if !errors.As(err, &conflict) || conflict.Exists {
+		t.Fatalf("UpdateEmail after delete err = %#v, want a conflict for a missing account", err)
+	}
+	if _, err := db.GetEmail(created.Email); !errors.Is(err, ErrAccountNotFound) {
+		t.Fatalf("GetEmail after delete err = %v, want ErrAccountNotFound", err)
+	}
+}
+
+// 버전 관리 이전 계정은 Version 0 으로 한 번 수정할 수 있고, 그 뒤로는 버전이 있어야 합니다.
+func TestUpdateLegacyEmail(t *testing.T) {
+	db := newLocalTable(t)
+	putRawItem(t, db, map[string]types.AttributeValue{
+		hashKey:    &types.AttributeValueMemberS{Value: "old@example.com"},
+		"password": &types.AttributeValueMemberS{Value: "pw"},
+	})
+
+	legacy, err := db.GetEmail("old@example.com")
+	if err != nil {
+		t.Fatalf("GetEmail: %v", err)
+	}
+	if legacy.Version != 0 {
+		t.Fatalf("legacy version = %d, want 0", legacy.Version)
+	}
+	updated, err := db.UpdateEmail(*legacy)
+	if err != nil {
+		t.Fatalf("UpdateEmail: %v", err)
+	}
+	if updated.Version != 1 || updated.CreatedAt.IsZero() {
+		t.Fatalf("updated = %+v, want version 1 with created_at filled in", updated)
+	}
+	if _, err := db.UpdateEmail(*legacy); !errors.Is(err, ErrConflict) {
+		t.Fatalf("second version 0 UpdateEmail err = %v, want conflict", err)
+	}
+}
@@ -0,0 +1,341 @@
+// internal/db/import.go
+
+package db
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// 가져오기 파일 형식
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+)
+
+// 가져오기 컬럼 매핑에 쓰는 계정 필드 이름
+const (
+	FieldEmail         = "email"
+	FieldPassword      = "password"
+	FieldRecoveryEmail = "recovery_email"
+	FieldUsed          = "used"
+)
+
+var importFields = []string{FieldEmail, FieldPassword, FieldRecoveryEmail, FieldUsed}
+
+// ImportOptions 는 계정 가져오기 파일을 읽는 방법입니다.
+type ImportOptions struct {
+	// Format 은 "csv" 또는 "json" 입니다. 비어 있으면 내용으로 판단합니다.
+	Format string `json:"format"`
+	// Delimiter 는 CSV 구분자입니다. 기본값은 ",".
+	Delimiter string `json:"delimiter"`
+	// NoHeader 가 true 이면 CSV 첫 줄도 데이터로 읽고, Columns 값은 1부터 시작하는 열 번호입니다.
+	NoHeader bool `json:"no_header"`
+	// Columns 는 계정 필드(email, password, recovery_email, used)를 CSV 헤더 이름/열 번호 또는 JSON 키에 매핑합니다.
+	// 지정하지 않은 필드는 같은 이름의 헤더(대소문자, "_", "-" 무시)를 사용하고, NoHeader 이면 위 순서대로 1~4 열을 사용합니다.
+	Columns map[string]string `json:"columns"`
+}
+
+// ImportRow 는 가져오기 파일의 한 행과 검증 결과입니다.
+type ImportRow struct {
+	// Line 은 CSV 의 줄 번호 또는 JSON 배열의 순번(1부터)입니다.
+	Line    int          `json:"line"`
+	Account GmailAccount `json:"account"`
+	Issues  []string     `json:"issues,omitempty"`
+}
+
+// ImportPreview 는 가져오기 결과 미리보기입니다. DryRun 이 아니면 Imported 에 실제 저장된 수가 들어가며,
+// 검증은 통과했지만 저장에 실패한 행은 그 행의 Issues 에 실패 사유가 추가됩니다.
+type ImportPreview struct {
+	Rows     []ImportRow `json:"rows"`
+	Valid    int         `json:"valid"`
+	Invalid  int         `json:"invalid"`
+	Imported int         `json:"imported"`
+	DryRun   bool        `json:"dry_run"`
+}
+
+// ParseAccounts 는 CSV 또는 JSON 가져오기 파일을 행 단위로 읽습니다. 파일 형식 자체가 잘못되면 오류를,
+// 행 단위 문제(필드 형식 등)는 각 행의 Issues 에 담아 반환합니다. 유효성 검증은 하지 않습니다.
+func ParseAccounts(data []byte, opts ImportOptions) ([]ImportRow, error) {
+	// 엑셀 등이 붙이는 UTF-8 BOM 은 형식 판단 전에 뗍니다.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	format := opts.Format
+	if format == "" {
+		format = ImportFormatCSV
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+			format = ImportFormatJSON
+		}
+	}
+
+	switch format {
+	case ImportFormatCSV:
+		return parseCSVAccounts(data, opts)
+	case ImportFormatJSON:
+		return parseJSONAccounts(data, opts)
+	default:
+		return nil, fmt.Errorf("unsupported import format %q", format)
+	}
+}
+
+func parseCSVAccounts(data []byte, opts ImportOptions) ([]ImportRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	if opts.Delimiter != "" {
+		if opts.Delimiter == `\t` {
+			opts.Delimiter = "\t"
+		}
+		delim, size := utf8.DecodeRuneInString(opts.Delimiter)
+		if size != len(opts.Delimiter) {
+			return nil, fmt.Errorf("delimiter must be a single character")
+		}
+		r.Comma = delim
+	}
+
+	var columns map[string]int
+	if opts.NoHeader {
+		var err error
+		if columns, err = positionColumns(opts.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []ImportRow
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+
+		if columns == nil {
+			if columns, err = headerColumns(record, opts.Columns); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		values := make(map[string]string, len(columns))
+		for field, i := range columns {
+			if i < len(record) {
+				values[field] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, accountRow(line, values))
+	}
+	return rows, nil
+}
+
+// headerColumns 는 CSV 헤더 행에서 각 필드의 열 위치를 찾습니다.
+func headerColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[normalizeKey(name)] = i
+	}
+
+	columns := make(map[string]int, len(importFields))
+	for _, field := range importFields {
+		name := field
+		if mapped, ok := mapping[field]; ok {
+			name = mapped
+		}
+		if i, ok := index[normalizeKey(name)]; ok {
+			columns[field] = i
+		} else if field == FieldEmail || field == FieldPassword {
+			return nil, fmt.Errorf("column %q for %s not found in CSV header", name, field)
+		}
+	}
+	return columns, nil
+}
+
+// positionColumns 는 헤더 없는 CSV 에서 1부터 시작하는 열 번호 매핑을 만듭니다.
+func positionColumns(mapping map[string]string) (map[string]int, error) {
+	columns := make(map[string]int, len(importFields))
+	for i, field := range importFields {
+		columns[field] = i
+		mapped, ok := mapping[field]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(mapped)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("column for %s must be a column number when the file has no header", field)
+		}
+		columns[field] = n - 1
+	}
+	return columns, nil
+}
+
+func parseJSONAccounts(data []byte, opts ImportOptions) ([]ImportRow, error) {
+	var records []map[string]interface{}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON (expected an array of objects): %w", err)
+	}
+
+	rows := make([]ImportRow, 0, len(records))
+	for i, record := range records {
+		byKey := make(map[string]interface{}, len(record))
+		for k, v := range record {
+			byKey[normalizeKey(k)] = v
+		}
+
+		values := make(map[string]string, len(importFields))
+		for _, field := range importFields {
+			name := field
+			if mapped, ok := opts.Columns[field]; ok {
+				name = mapped
+			}
+			switch v := byKey[normalizeKey(name)].(type) {
+			case nil:
+			case string:
+				values[field] = strings.TrimSpace(v)
+			default:
+				values[field] = fmt.Sprint(v)
+			}
+		}
+		rows = append(rows, accountRow(i+1, values))
+	}
+	return rows, nil
+}
+
+func accountRow(line int, values map[string]string) ImportRow {
+	row := ImportRow{
+		Line: line,
+		Account: GmailAccount{
+			Email:         values[FieldEmail],
+			Password:      values[FieldPassword],
+			RecoveryEmail: values[FieldRecoveryEmail],
+		},
+	}
+	if v := values[FieldUsed]; v != "" {
+		used, err := parseUsed(v)
+		if err != nil {
+			row.Issues = append(row.Issues, err.Error())
+		}
+		row.Account.Used = used
+	}
+	return row
+}
+
+func parseUsed(v string) (bool, error) {
+	switch strings.ToLower(v) {
+	case "y", "yes", "o":
+		return true, nil
+	case "n", "no", "x":
+		return false, nil
+	}
+	used, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid used value %q", v)
+	}
+	return used, nil
+}
+
+// normalizeKey 는 헤더/키 비교를 위해 대소문자, "_", "-", 공백을 무시합니다.
+func normalizeKey(s string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// ValidateAccount 는 계정의 필수 필드와 이메일 형식을 확인하고 문제 목록을 반환합니다.
+func ValidateAccount(account GmailAccount) []string {
+	var issues []string
+	if account.Email == "" {
+		issues = append(issues, "email is required")
+	} else if !validEmail(account.Email) {
+		issues = append(issues, fmt.Sprintf("invalid email address %q", account.Email))
+	}
+	if account.Password == "" {
+		issues = append(issues, "password is required")
+	}
+	if account.RecoveryEmail != "" && !validEmail(account.RecoveryEmail) {
+		issues = append(issues, fmt.Sprintf("invalid recovery email address %q", account.RecoveryEmail))
+	}
+	return issues
+}
+
+func validEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
+// accountWriter 는 계정 가져오기가 쓰는 저장소 작업입니다. 테스트에서는 가짜 저장소를 넣습니다.
+type accountWriter interface {
+	ListEmails() ([]GmailAccount, error)
+	CreateEmail(email GmailAccount) (*GmailAccount, error)
+}
+
+// ImportAccounts 는 가져오기 파일을 읽고 행마다 검증합니다. 파일 안의 중복과 저장소에 이미 있는
+// 계정도 문제로 표시합니다. dryRun 이 false 이면 문제가 없는 행만 한 행씩 저장하며,
+// 한 행의 저장 실패가 나머지 행을 막지 않습니다.
+func (db *EmailDB) ImportAccounts(data []byte, opts ImportOptions, dryRun bool) (*ImportPreview, error) {
+	return importAccounts(db, data, opts, dryRun)
+}
+
+func importAccounts(store accountWriter, data []byte, opts ImportOptions, dryRun bool) (*ImportPreview, error) {
+	rows, err := ParseAccounts(data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := store.ListEmails()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load existing accounts: %w", err)
+	}
+	stored := make(map[string]bool, len(existing))
+	for _, a := range existing {
+		stored[strings.ToLower(a.Email)] = true
+	}
+
+	preview := &ImportPreview{Rows: rows, DryRun: dryRun}
+	firstLine := make(map[string]int, len(rows))
+	var valid []*ImportRow
+	for i := range rows {
+		row := &rows[i]
+		row.Issues = append(row.Issues, ValidateAccount(row.Account)...)
+
+		key := strings.ToLower(row.Account.Email)
+		if key != "" {
+			if line, ok := firstLine[key]; ok {
+				row.Issues = append(row.Issues, fmt.Sprintf("duplicate of line %d", line))
+			} else {
+				firstLine[key] = row.Line
+			}
+			if stored[key] {
+				row.Issues = append(row.Issues, "account already exists")
+			}
+		}
+
+		if len(row.Issues) > 0 {
+			preview.Invalid++
+			continue
+		}
+		preview.Valid++
+		valid = append(valid, row)
+	}
+
+	if dryRun {
+		return preview, nil
+	}
+	for _, row := range valid {
+		if _, err := store.CreateEmail(row.Account); err != nil {
+			row.Issues = append(row.Issues, fmt.Sprintf("not saved: %v", err))
+			continue
+		}
+		preview.Imported++
+	}
+	return preview, nil
+}
@@ -0,0 +1,232 @@
+// internal/db/import_test.go
+
+package db
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeAccountStore 는 메모리에 계정을 두는 가짜 저장소입니다. failOn 의 이메일은 저장에 실패합니다.
+type fakeAccountStore struct {
+	accounts []GmailAccount
+	created  []GmailAccount
+	failOn   map[string]bool
+}
+
+func (s *fakeAccountStore) ListEmails() ([]GmailAccount, error) {
+	return s.accounts, nil
+}
+
+func (s *fakeAccountStore) CreateEmail(email GmailAccount) (*GmailAccount, error) {
+	if s.failOn[email.Email] {
+		return nil, fmt.Errorf("throttled")
+	}
+	s.created = append(s.created, email)
+	s.accounts = append(s.accounts, email)
+	return &email, nil
+}
+
+func TestParseAccounts(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		opts ImportOptions
+		want []ImportRow
+	}{
+		{
+			name: "header with default names",
+			data: "Email,Password,Recovery-Email,used\na@example.com,pw1,r@example.com,yes\n",
+			want: []ImportRow{{Line: 2, Account: GmailAccount{Email: "a@example.com", Password: "pw1", RecoveryEmail: "r@example.com", Used: true}}},
+		},
+		{
+			name: "custom delimiter and column mapping",
+			data: "Mail;Secret;Extra\na@example.com;pw1;x\nb@example.com;pw2;y\n",
+			opts: ImportOptions{Delimiter: ";", Columns: map[string]string{FieldEmail: "mail", FieldPassword: "secret"}},
+			want: []ImportRow{
+				{Line: 2, Account: GmailAccount{Email: "a@example.com", Password: "pw1"}},
+				{Line: 3, Account: GmailAccount{Email: "b@example.com", Password: "pw2"}},
+			},
+		},
+		{
+			name: "tab delimiter",
+			data: "email\tpassword\na@example.com\tpw1\n",
+			opts: ImportOptions{Delimiter: `\t`},
+			want: []ImportRow{{Line: 2, Account: GmailAccount{Email: "a@example.com", Password: "pw1"}}},
+		},
+		{
+			name: "no header uses field order",
+			data: "a@example.com,pw1,r@example.com,o\n\nb@example.com,pw2\n",
+			opts: ImportOptions{NoHeader: true},
+			want: []ImportRow{
+				{Line: 1, Account: GmailAccount{Email: "a@example.com", Password: "pw1", RecoveryEmail: "r@example.com", Used: true}},
+				{Line: 3, Account: GmailAccount{Email: "b@example.com", Password: "pw2"}},
+			},
+		},
+		{
+			name: "no header with column numbers",
+			data: "pw1,a@example.com\n",
+			opts: ImportOptions{NoHeader: true, Columns: map[string]string{FieldEmail: "2", FieldPassword: "1"}},
+			want: []ImportRow{{Line: 1, Account: GmailAccount{Email: "a@example.com", Password: "pw1"}}},
+		},
+		{
+			name: "utf-8 bom",
+			data: "\xef\xbb\xbfemail,password\na@example.com,pw1\n",
+			want: []ImportRow{{Line: 2, Account: GmailAccount{Email: "a@example.com", Password: "pw1"}}},
+		},
+		{
+			name: "json with mapping",
+			data: `[{"Mail":"a@example.com","password":" pw1 ","used":true},{"Mail":"b@example.com","password":"pw2","recovery_email":"r@example.com"}]`,
+			opts: ImportOptions{Columns: map[string]string{FieldEmail: "mail"}},
+			want: []ImportRow{
+				{Line: 1, Account: GmailAccount{Email: "a@example.com", Password: "pw1", Used: true}},
+				{Line: 2, Account: GmailAccount{Email: "b@example.com", Password: "pw2", RecoveryEmail: "r@example.com"}},
+			},
+		},
+		{
+			name: "json with bom",
+			data: "\xef\xbb\xbf" + `[{"email":"a@example.com","password":"pw1"}]`,
+			want: []ImportRow{{Line: 1, Account: GmailAccount{Email: "a@example.com", Password: "pw1"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseAccounts([]byte(tt.data), tt.opts)
+			if err != nil {
+				t.Fatalf("ParseAccounts: %v", err)
+			}
+			if !reflect.DeepEqual(rows, tt.want) {
+				t.Fatalf("rows = %+v, want %+v", rows, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAccountsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		opts ImportOptions
+		want string
+	}{
+		{"missing password column", "email,recovery\na@example.com,x\n", ImportOptions{}, `column "password"`},
+		{"mapped column missing", "email,password\n", ImportOptions{Columns: map[string]string{FieldEmail: "mail"}}, `column "mail"`},
+		{"multi-character delimiter", "email,password\n", ImportOptions{Delimiter: "::"}, "single character"},
+		{"no header with column name", "a,b\n", ImportOptions{NoHeader: true, Columns: map[string]string{FieldEmail: "email"}}, "column number"},
+		{"json object", `{"email":"a@example.com"}`, ImportOptions{}, "array of objects"},
+		{"unknown format", "x", ImportOptions{Format: "xml"}, "unsupported import format"},
+	}
+	for _, tt := range tests {
+		if _, err := ParseAccounts([]byte(tt.data), tt.opts); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: ParseAccounts err = %v, want it to mention %s", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestParseUsed(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+		ok   bool
+	}{
+		{"yes", true, true}, {"Y", true, true}, {"o", true, true}, {"true", true, true}, {"1", true, true},
+		{"no", false, true}, {"N", false, true}, {"x", false, true}, {"false", false, true}, {"0", false, true},
+		{"maybe", false, false}, {"2", false, false},
+	}
+	for _, tt := range tests {
+		got, err := parseUsed(tt.in)
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("parseUsed(%q) = %v, %v; want %v, ok %v", tt.in, got, err, tt.want, tt.ok)
+		}
+	}
+
+	// 잘못된 값은 파일 오류가 아니라 그 행의 문제로 남습니다.
+	rows, err := ParseAccounts([]byte("email,password,used\na@example.com,pw,maybe\n"), ImportOptions{})
+	if err != nil {
+		t.Fatalf("ParseAccounts: %v", err)
+	}
+	if len(rows) != 1 || !reflect.DeepEqual(rows[0].Issues, []string{`invalid used value "maybe"`}) {
+		t.Fatalf("rows = %+v, want an invalid used issue", rows)
+	}
+}
+
+func TestValidateAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		account GmailAccount
+		want    []string
+	}{
+		{"valid", GmailAccount{Email: "a@example.com", Password: "pw"}, nil},
+		{"missing fields", GmailAccount{}, []string{"email is required", "password is required"}},
+		{"invalid email", GmailAccount{Email: "not-an-email", Password: "pw"}, []string{`invalid email address "not-an-email"`}},
+		{"display name", GmailAccount{Email: "A <a@example.com>", Password: "pw"}, []string{`invalid email address "A <a@example.com>"`}},
+		{"invalid recovery", GmailAccount{Email: "a@example.com", Password: "pw", RecoveryEmail: "r@"}, []string{`invalid recovery email address "r@"`}},
+	}
+	for _, tt := range tests {
+		if got := ValidateAccount(tt.account); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ValidateAccount = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+const importFile = `email,password
+new@example.com,pw1
+Old@Example.com,pw2
+,pw3
+new@example.com,pw4
+bad-address,pw5
+second@example.com,pw6
+`
+
+func TestImportAccountsDryRun(t *testing.T) {
+	store := &fakeAccountStore{accounts: []GmailAccount{{Email: "old@example.com"}}}
+
+	preview, err := importAccounts(store, []byte(importFile), ImportOptions{}, true)
+	if err != nil {
+		t.Fatalf("importAccounts: %v", err)
+	}
+	if len(store.created) != 0 {
+		t.Fatalf("dry run saved %d accounts", len(store.created))
+	}
+	if !preview.DryRun || preview.Valid != 2 || preview.Invalid != 4 || preview.Imported != 0 {
+		t.Fatalf("preview = valid %d, invalid %d, imported %d, dry run %v", preview.Valid, preview.Invalid, preview.Imported, preview.DryRun)
+	}
+
+	want := map[int][]string{
+		2: nil,
+		3: {"account already exists"},
+		4: {"email is required"},
+		5: {"duplicate of line 2"},
+		6: {`invalid email address "bad-address"`},
+		7: nil,
+	}
+	for _, row := range preview.Rows {
+		if !reflect.DeepEqual(row.Issues, want[row.Line]) {
+			t.Errorf("line %d issues = %q, want %q", row.Line, row.Issues, want[row.Line])
+		}
+	}
+}
+
+func TestImportAccountsSavesValidRows(t *testing.T) {
+	store := &fakeAccountStore{
+		accounts: []GmailAccount{{Email: "old@example.com"}},
+		failOn:   map[string]bool{"second@example.com": true},
+	}
+
+	preview, err := importAccounts(store, []byte(importFile), ImportOptions{}, false)
+	if err != nil {
+		t.Fatalf("importAccounts: %v", err)
+	}
+	if len(store.created) != 1 || store.created[0].Email != "new@example.com" || store.created[0].Password != "pw1" {
+		t.Fatalf("created = %+v, want only new@example.com", store.created)
+	}
+	if preview.Valid != 2 || preview.Imported != 1 {
+		t.Fatalf("preview = valid %d, imported %d, want 2 and 1", preview.Valid, preview.Imported)
+	}
+	last := preview.Rows[len(preview.Rows)-1]
+	if !reflect.DeepEqual(last.Issues, []string{"not saved: throttled"}) {
+		t.Fatalf("failed row issues = %q", last.Issues)
+	}
+}
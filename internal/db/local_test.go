@@ -0,0 +1,87 @@
+// internal/db/local_test.go
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// 저장소 테스트는 DynamoDB Local 이 필요합니다. 예:
+//
+//	docker run -p 8000:8000 amazon/dynamodb-local
+//	COOKIEBOT_DYNAMODB_ENDPOINT=http://localhost:8000 go test ./internal/db
+const localEndpointEnv = "COOKIEBOT_DYNAMODB_ENDPOINT"
+
+// newLocalDB 는 DynamoDB Local 에서 테스트마다 새 테이블 이름을 쓰는 EmailDB 를 만듭니다. 테이블은 만들지 않으며,
+// 테스트가 끝나면 남아 있는 테이블을 지웁니다. 환경 변수가 없으면 테스트를 건너뜁니다.
+func newLocalDB(t *testing.T) *EmailDB {
+	t.Helper()
+	endpoint := os.Getenv(localEndpointEnv)
+	if endpoint == "" {
+		t.Skipf("%s is not set", localEndpointEnv)
+	}
+
+	table := fmt.Sprintf("cookiebot-test-%d", time.Now().UnixNano())
+	config, err := json.Marshal(map[string]interface{}{
+		"AWS":       map[string]string{"Region": "us-east-1", "AccessKeyID": "local", "SecretAccessKey": "local", "Endpoint": endpoint},
+		"TableName": table,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, config, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewEmailDB(path, nil)
+	if err != nil {
+		t.Fatalf("NewEmailDB: %v", err)
+	}
+	t.Cleanup(func() {
+		db.client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{TableName: aws.String(table)})
+	})
+	return db
+}
+
+// newLocalTable 은 계정 테이블까지 만든 EmailDB 를 반환합니다.
+func newLocalTable(t *testing.T) *EmailDB {
+	t.Helper()
+	db := newLocalDB(t)
+	if err := db.CreateTable(context.Background()); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+	return db
+}
+
+// putRawItem 은 버전 관리 이전 형식처럼 EmailDB 를 거치지 않고 항목을 씁니다.
+func putRawItem(t *testing.T, db *EmailDB, item map[string]types.AttributeValue) {
+	t.Helper()
+	_, err := db.client.PutItem(context.Background(), &dynamodb.PutItemInput{TableName: aws.String(db.tableName), Item: item})
+	if err != nil {
+		t.Fatalf("PutItem: %v", err)
+	}
+}
+
+func getRawItem(t *testing.T, db *EmailDB, email string) map[string]types.AttributeValue {
+	t.Helper()
+	out, err := db.client.GetItem(context.Background(), &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName),
+		Key:            map[string]types.AttributeValue{hashKey: &types.AttributeValueMemberS{Value: email}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("GetItem: %v", err)
+	}
+	return out.Item
+}
@@ -0,0 +1,334 @@
+// internal/db/migrate.go
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// schemaMarkerKey 는 저장소의 스키마 버전과 진행 중인 마이그레이션 위치를 담는 항목의 키입니다.
+// 이메일 주소가 될 수 없는 값이라 계정과 겹치지 않으며, 계정 목록에서는 제외됩니다.
+const schemaMarkerKey = "#schema"
+
+// 마이그레이션 한 번에 읽는 기본 항목 수
+const defaultMigrationPageSize = 100
+
+// 마이그레이션 쓰기가 동시 수정과 충돌했을 때 다시 읽어 적용하는 최대 횟수
+const migrationWriteAttempts = 3
+
+// Migration 은 계정 항목 하나를 새 형식으로 바꾸는 번호 붙은 마이그레이션입니다.
+// Apply 는 같은 항목에 여러 번 적용되어도 결과가 같아야 합니다. 중단 후 재개하면 마지막 페이지를 다시 처리하기 때문입니다.
+type Migration struct {
+	Version int
+	Name    string
+	// Apply 는 항목을 직접 수정하고, 바뀐 것이 있으면 true 를 반환합니다.
+	Apply func(item map[string]types.AttributeValue) bool
+}
+
+// Migrations 는 적용 순서대로 나열한 계정 마이그레이션입니다. 새 마이그레이션은 끝에 다음 번호로 추가합니다.
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "fill missing account attributes",
+		Apply: func(item map[string]types.AttributeValue) bool {
+			changed := false
+			if _, ok := item["recovery_email"]; !ok {
+				item["recovery_email"] = &types.AttributeValueMemberS{Value: ""}
+				changed = true
+			}
+			if _, ok := item["used"]; !ok {
+				item["used"] = &types.AttributeValueMemberBOOL{Value: false}
+				changed = true
+			}
+			return changed
+		},
+	},
+	{
+		Version: 2,
+		Name:    "add version and timestamps",
+		Apply: func(item map[string]types.AttributeValue) bool {
+			changed := false
+			now := &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)}
+			if _, ok := item["version"]; !ok {
+				item["version"] = &types.AttributeValueMemberN{Value: "1"}
+				changed = true
+			}
+			if _, ok := item["created_at"]; !ok {
+				item["created_at"] = now
+				changed = true
+			}
+			if _, ok := item["updated_at"]; !ok {
+				item["updated_at"] = now
+				changed = true
+			}
+			return changed
+		},
+	},
+}
+
+// MigrationOptions 는 마이그레이션 실행 방법입니다.
+type MigrationOptions struct {
+	// DryRun 이면 바뀔 항목 수만 세고 아무것도 쓰지 않습니다.
+	// 이전 마이그레이션이 적용되지 않은 상태에서 다음 마이그레이션을 세므로 뒤쪽 수치는 대략적입니다.
+	DryRun bool
+	// PageSize 는 Scan 한 번에 읽는 항목 수입니다. 0 이면 기본값을 사용합니다.
+	PageSize int32
+	// OnProgress 는 페이지를 처리할 때마다 호출됩니다.
+	OnProgress func(MigrationProgress)
+}
+
+// MigrationProgress 는 진행 중인 마이그레이션 상태입니다.
+type MigrationProgress struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	Scanned int    `json:"scanned"`
+	Changed int    `json:"changed"`
+	Done    bool   `json:"done"`
+	DryRun  bool   `json:"dry_run"`
+}
+
+// MigrationReport 는 Migrate 결과입니다.
+type MigrationReport struct {
+	From    int                 `json:"from"`
+	To      int                 `json:"to"`
+	Applied []MigrationProgress `json:"applied"`
+	DryRun  bool                `json:"dry_run"`
+}
+
+// schemaMarker 는 저장소에 기록된 마이그레이션 상태입니다.
+type schemaMarker struct {
+	Version int
+	// Running 은 중단된 마이그레이션 번호이며, Cursor 는 그 마이그레이션이 마지막으로 끝낸 페이지의 키입니다.
+	Running int
+	Cursor  string
+}
+
+// LatestSchemaVersion 은 코드에 정의된 가장 최근 마이그레이션 번호입니다.
+func LatestSchemaVersion() int {
+	if len(Migrations) == 0 {
+		return 0
+	}
+	return Migrations[len(Migrations)-1].Version
+}
+
+// SchemaVersion 은 저장소에 마지막으로 적용된 마이그레이션 번호를 반환합니다.
+func (db *EmailDB) SchemaVersion(ctx context.Context) (int, error) {
+	marker, err := db.readMarker(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return marker.Version, nil
+}
+
+// Migrate 는 저장소 버전 이후의 마이그레이션을 순서대로 적용합니다. 페이지마다 진행 위치를 기록하므로
+// 중간에 중단되면 다음 실행 때 그 위치부터 이어서 진행합니다. DynamoDB 와 DynamoDB Local 에서 동일하게 동작합니다.
+func (db *EmailDB) Migrate(ctx context.Context, opts MigrationOptions) (report *MigrationReport, err error) {
+	defer func() {
+		if opts.DryRun || report == nil || (report.From == report.To && err == nil) {
+			return
+		}
+		db.audit.Record("store.migrate", db.tableName, map[string]interface{}{"from": report.From, "to": report.To}, err)
+	}()
+
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultMigrationPageSize
+	}
+
+	marker, err := db.readMarker(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report = &MigrationReport{From: marker.Version, To: marker.Version, DryRun: opts.DryRun}
+
+	for _, m := range Migrations {
+		if m.Version <= marker.Version {
+			continue
+		}
+
+		var cursor map[string]types.AttributeValue
+		if marker.Running == m.Version && marker.Cursor != "" && !opts.DryRun {
+			cursor = map[string]types.AttributeValue{hashKey: &types.AttributeValueMemberS{Value: marker.Cursor}}
+		}
+
+		progress, err := db.runMigration(ctx, m, marker.Version, cursor, opts)
+		report.Applied = append(report.Applied, progress)
+		if err != nil {
+			return report, fmt.Errorf("migration %d (%s) failed after %d items: %w", m.Version, m.Name, progress.Scanned, err)
+		}
+
+		if !opts.DryRun {
+			marker = schemaMarker{Version: m.Version}
+			if err := db.writeMarker(ctx, marker); err != nil {
+				return report, err
+			}
+		}
+		report.To = m.Version
+	}
+	return report, nil
+}
+
+// runMigration 은 마이그레이션 하나를 모든 항목에 적용합니다. from 은 이 마이그레이션 이전의 저장소 버전입니다.
+func (db *EmailDB) runMigration(ctx context.Context, m Migration, from int, cursor map[string]types.AttributeValue, opts MigrationOptions) (MigrationProgress, error) {
+	progress := MigrationProgress{Version: m.Version, Name: m.Name, DryRun: opts.DryRun}
+
+	err := db.scanPages(ctx, opts.PageSize, cursor, func(items []map[string]types.AttributeValue, next map[string]types.AttributeValue) error {
+		for _, item := range items {
+			progress.Scanned++
+			version := item["version"]
+			if !m.Apply(item) {
+				continue
+			}
+			progress.Changed++
+			if opts.DryRun {
+				continue
+			}
+			if err := db.writeMigrated(ctx, m, item, version); err != nil {
+				return err
+			}
+		}
+
+		if !opts.DryRun && next != nil {
+			if v, ok := next[hashKey].(*types.AttributeValueMemberS); ok {
+				if err := db.writeMarker(ctx, schemaMarker{Version: from, Running: m.Version, Cursor: v.Value}); err != nil {
+					return err
+				}
+			}
+		}
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+		return nil
+	})
+
+	progress.Done = err == nil
+	if err == nil && opts.OnProgress != nil {
+		opts.OnProgress(progress)
+	}
+	return progress, err
+}
+
+// writeMigrated 는 마이그레이션한 항목을 저장합니다. version 은 Apply 전 항목의 버전 속성이며,
+// 읽은 뒤 다른 사용자가 수정했다면 다시 읽어 적용합니다.
+func (db *EmailDB) writeMigrated(ctx context.Context, m Migration, item map[string]types.AttributeValue, version types.AttributeValue) error {
+	for attempt := 0; ; attempt++ {
+		input := &dynamodb.PutItemInput{
+			TableName:           aws.String(db.tableName),
+			Item:                item,
+			ConditionExpression: aws.String("attribute_exists(email) AND attribute_not_exists(version)"),
+		}
+		if version != nil {
+			input.ConditionExpression = aws.String("version = :v")
+			input.ExpressionAttributeValues = map[string]types.AttributeValue{":v": version}
+		}
+		_, err := db.client.PutItem(ctx, input)
+		var ccf *types.ConditionalCheckFailedException
+		if !errors.As(err, &ccf) || attempt+1 >= migrationWriteAttempts {
+			return err
+		}
+
+		current, err := db.client.GetItem(ctx, &dynamodb.GetItemInput{
+			TableName:      aws.String(db.tableName),
+			Key:            map[string]types.AttributeValue{hashKey: item[hashKey]},
+			ConsistentRead: aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+		if current.Item == nil {
+			// 그 사이 삭제된 계정은 되살리지 않습니다.
+			return nil
+		}
+		item = current.Item
+		version = item["version"]
+		if !m.Apply(item) {
+			return nil
+		}
+	}
+}
+
+// scanPages 는 계정 테이블을 페이지 단위로 읽어 fn 에 넘깁니다. 스키마 표시 항목은 제외합니다.
+// next 는 다음 페이지의 시작 키이며 마지막 페이지에서는 nil 입니다.
+func (db *EmailDB) scanPages(ctx context.Context, limit int32, start map[string]types.AttributeValue, fn func(items []map[string]types.AttributeValue, next map[string]types.AttributeValue) error) error {
+	input := &dynamodb.ScanInput{
+		TableName:         aws.String(db.tableName),
+		ExclusiveStartKey: start,
+	}
+	if limit > 0 {
+		input.Limit = aws.Int32(limit)
+	}
+
+	for {
+		result, err := db.client.Scan(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		items := result.Items[:0]
+		for _, item := range result.Items {
+			if v, ok := item[hashKey].(*types.AttributeValueMemberS); ok && v.Value == schemaMarkerKey {
+				continue
+			}
+			items = append(items, item)
+		}
+		if err := fn(items, result.LastEvaluatedKey); err != nil {
+			return err
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+func (db *EmailDB) readMarker(ctx context.Context) (schemaMarker, error) {
+	result, err := db.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName),
+		Key:            map[string]types.AttributeValue{hashKey: &types.AttributeValueMemberS{Value: schemaMarkerKey}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return schemaMarker{}, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	var marker schemaMarker
+	if v, ok := result.Item["schema_version"].(*types.AttributeValueMemberN); ok {
+		marker.Version, _ = strconv.Atoi(v.Value)
+	}
+	if v, ok := result.Item["migration_running"].(*types.AttributeValueMemberN); ok {
+		marker.Running, _ = strconv.Atoi(v.Value)
+	}
+	if v, ok := result.Item["migration_cursor"].(*types.AttributeValueMemberS); ok {
+		marker.Cursor = v.Value
+	}
+	return marker, nil
+}
+
+func (db *EmailDB) writeMarker(ctx context.Context, marker schemaMarker) error {
+	item := map[string]types.AttributeValue{
+		hashKey:          &types.AttributeValueMemberS{Value: schemaMarkerKey},
+		"schema_version": &types.AttributeValueMemberN{Value: strconv.Itoa(marker.Version)},
+		"updated_at":     &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)},
+	}
+	if marker.Running > 0 {
+		item["migration_running"] = &types.AttributeValueMemberN{Value: strconv.Itoa(marker.Running)}
+		item["migration_cursor"] = &types.AttributeValueMemberS{Value: marker.Cursor}
+	}
+
+	_, err := db.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName),
+		Item:      item,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write schema version: %w", err)
+	}
+	return nil
+}
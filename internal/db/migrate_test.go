@@ -0,0 +1,110 @@
+// internal/db/migrate_test.go
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// putLegacyAccounts 는 recovery_email, used, version 이 없는 예전 형식의 계정 n 개를 씁니다.
+func putLegacyAccounts(t *testing.T, db *EmailDB, n int) []string {
+	t.Helper()
+	emails := make([]string, n)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("legacy%d@example.com", i)
+		putRawItem(t, db, map[string]types.AttributeValue{
+			hashKey:    &types.AttributeValueMemberS{Value: emails[i]},
+			"password": &types.AttributeValueMemberS{Value: "pw"},
+		})
+	}
+	return emails
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	db := newLocalTable(t)
+	emails := putLegacyAccounts(t, db, 5)
+
+	report, err := db.Migrate(context.Background(), MigrationOptions{DryRun: true, PageSize: 2})
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	if len(report.Applied) != len(Migrations) || report.Applied[0].Changed != len(emails) {
+		t.Fatalf("dry run report = %+v, want every migration to count %d items", report, len(emails))
+	}
+	if version, err := db.SchemaVersion(context.Background()); err != nil || version != 0 {
+		t.Fatalf("SchemaVersion after dry run = %d, %v; want 0", version, err)
+	}
+	if item := getRawItem(t, db, emails[0]); item["version"] != nil {
+		t.Fatalf("dry run wrote %v", item)
+	}
+}
+
+// 페이지 도중 중단된 마이그레이션은 기록된 위치부터 이어서 진행하고 모든 항목을 바꿔야 합니다.
+func TestMigrateResumesAfterInterruption(t *testing.T) {
+	db := newLocalTable(t)
+	emails := putLegacyAccounts(t, db, 9)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pages := 0
+	_, err := db.Migrate(ctx, MigrationOptions{PageSize: 2, OnProgress: func(p MigrationProgress) {
+		if pages++; pages == 2 {
+			cancel()
+		}
+	}})
+	cancel()
+	if err == nil {
+		t.Fatalf("Migrate finished even though the context was cancelled")
+	}
+
+	marker, err := db.readMarker(context.Background())
+	if err != nil {
+		t.Fatalf("readMarker: %v", err)
+	}
+	if marker.Version != 0 || marker.Running != Migrations[0].Version || marker.Cursor == "" {
+		t.Fatalf("marker after interruption = %+v, want migration %d running with a cursor", marker, Migrations[0].Version)
+	}
+
+	report, err := db.Migrate(context.Background(), MigrationOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Migrate (resume): %v", err)
+	}
+	if report.From != 0 || report.To != LatestSchemaVersion() {
+		t.Fatalf("report = %+v, want 0 -> %d", report, LatestSchemaVersion())
+	}
+	if scanned := report.Applied[0].Scanned; scanned == 0 || scanned >= len(emails) {
+		t.Fatalf("resumed migration scanned %d of %d items, want it to start at the cursor", scanned, len(emails))
+	}
+
+	for _, email := range emails {
+		item := getRawItem(t, db, email)
+		for _, attr := range []string{"recovery_email", "used", "version", "created_at", "updated_at"} {
+			if item[attr] == nil {
+				t.Fatalf("%s is missing %s after migration: %v", email, attr, item)
+			}
+		}
+		if account := accountFromItem(item); account.Version != 1 {
+			t.Fatalf("%s version = %d, want 1", email, account.Version)
+		}
+	}
+
+	marker, err = db.readMarker(context.Background())
+	if err != nil {
+		t.Fatalf("readMarker: %v", err)
+	}
+	if marker.Version != LatestSchemaVersion() || marker.Running != 0 || marker.Cursor != "" {
+		t.Fatalf("marker after migration = %+v", marker)
+	}
+
+	again, err := db.Migrate(context.Background(), MigrationOptions{})
+	if err != nil || len(again.Applied) != 0 {
+		t.Fatalf("second Migrate = %+v, %v; want nothing to do", again, err)
+	}
+	accounts, err := db.ListEmails()
+	if err != nil || len(accounts) != len(emails) {
+		t.Fatalf("ListEmails = %d accounts, %v; want %d without the schema marker", len(accounts), err, len(emails))
+	}
+}
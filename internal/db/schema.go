@@ -0,0 +1,97 @@
+// internal/db/schema.go
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// 계정 테이블의 해시 키
+const hashKey = "email"
+
+// 테이블 생성 후 ACTIVE 가 될 때까지 기다리는 최대 시간
+const createTableTimeout = 2 * time.Minute
+
+// SchemaError 는 계정 테이블이 없거나 키 구성이 맞지 않을 때 반환됩니다.
+type SchemaError struct {
+	Table string
+	// Missing 이면 테이블이 없으므로 CreateTable 로 만들 수 있습니다.
+	Missing bool
+	Problem string
+}
+
+func (e *SchemaError) Error() string {
+	if e.Missing {
+		return fmt.Sprintf("accounts table %q does not exist; check TableName/Region in config or create the table", e.Table)
+	}
+	return fmt.Sprintf("accounts table %q has an unexpected schema: %s (expected %q as the only key, type string)", e.Table, e.Problem, hashKey)
+}
+
+// CheckSchema 는 DescribeTable 로 계정 테이블이 있는지, email(문자열)이 유일한 해시 키인지 확인합니다.
+// 앱 시작 시 호출해 잘못된 설정이 Scan 깊숙한 곳에서 실패하지 않게 합니다.
+func (db *EmailDB) CheckSchema(ctx context.Context) error {
+	out, err := db.client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(db.tableName)})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return &SchemaError{Table: db.tableName, Missing: true}
+		}
+		return fmt.Errorf("failed to describe accounts table %q: %w", db.tableName, err)
+	}
+	table := out.Table
+
+	if len(table.KeySchema) != 1 {
+		return &SchemaError{Table: db.tableName, Problem: fmt.Sprintf("table has %d key attributes", len(table.KeySchema))}
+	}
+	key := table.KeySchema[0]
+	if aws.ToString(key.AttributeName) != hashKey || key.KeyType != types.KeyTypeHash {
+		return &SchemaError{Table: db.tableName, Problem: fmt.Sprintf("key is %q (%s)", aws.ToString(key.AttributeName), key.KeyType)}
+	}
+	for _, def := range table.AttributeDefinitions {
+		if aws.ToString(def.AttributeName) == hashKey && def.AttributeType != types.ScalarAttributeTypeS {
+			return &SchemaError{Table: db.tableName, Problem: fmt.Sprintf("key type is %s", def.AttributeType)}
+		}
+	}
+	if table.TableStatus != types.TableStatusActive && table.TableStatus != types.TableStatusUpdating {
+		return fmt.Errorf("accounts table %q is not ready (status %s)", db.tableName, table.TableStatus)
+	}
+	return nil
+}
+
+// CreateTable 은 email 을 해시 키로 하는 온디맨드 계정 테이블을 만들고 ACTIVE 가 될 때까지 기다립니다.
+// 주로 DynamoDB Local 이나 새 환경을 준비할 때 사용합니다.
+func (db *EmailDB) CreateTable(ctx context.Context) (err error) {
+	defer func() { db.audit.Record("store.create_table", db.tableName, nil, err) }()
+
+	_, err = db.client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(db.tableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String(hashKey), AttributeType: types.ScalarAttributeTypeS},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String(hashKey), KeyType: types.KeyTypeHash},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create accounts table %q: %w", db.tableName, err)
+	}
+
+	waiter := dynamodb.NewTableExistsWaiter(db.client)
+	if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(db.tableName)}, createTableTimeout); err != nil {
+		return fmt.Errorf("accounts table %q was not ready in time: %w", db.tableName, err)
+	}
+	return nil
+}
+
+// TableName 은 설정된 계정 테이블 이름을 반환합니다.
+func (db *EmailDB) TableName() string {
+	return db.tableName
+}
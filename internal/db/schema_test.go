@@ -0,0 +1,87 @@
+// internal/db/schema_test.go
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestCheckSchemaMissingTableAndCreate(t *testing.T) {
+	db := newLocalDB(t)
+	ctx := context.Background()
+
+	var schemaErr *SchemaError
+	if err := db.CheckSchema(ctx); !errors.As(err, &schemaErr) || !schemaErr.Missing {
+		t.Fatalf("CheckSchema before CreateTable err = %v, want a missing table", err)
+	}
+	if err := db.CreateTable(ctx); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+	if err := db.CheckSchema(ctx); err != nil {
+		t.Fatalf("CheckSchema after CreateTable: %v", err)
+	}
+	if err := db.CreateTable(ctx); err == nil {
+		t.Fatalf("CreateTable succeeded for an existing table")
+	}
+}
+
+func TestCheckSchemaWrongKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs []types.AttributeDefinition
+		keys  []types.KeySchemaElement
+	}{
+		{
+			"other key name",
+			[]types.AttributeDefinition{{AttributeName: aws.String("id"), AttributeType: types.ScalarAttributeTypeS}},
+			[]types.KeySchemaElement{{AttributeName: aws.String("id"), KeyType: types.KeyTypeHash}},
+		},
+		{
+			"number key",
+			[]types.AttributeDefinition{{AttributeName: aws.String(hashKey), AttributeType: types.ScalarAttributeTypeN}},
+			[]types.KeySchemaElement{{AttributeName: aws.String(hashKey), KeyType: types.KeyTypeHash}},
+		},
+		{
+			"composite key",
+			[]types.AttributeDefinition{
+				{AttributeName: aws.String(hashKey), AttributeType: types.ScalarAttributeTypeS},
+				{AttributeName: aws.String("created_at"), AttributeType: types.ScalarAttributeTypeS},
+			},
+			[]types.KeySchemaElement{
+				{AttributeName: aws.String(hashKey), KeyType: types.KeyTypeHash},
+				{AttributeName: aws.String("created_at"), KeyType: types.KeyTypeRange},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newLocalDB(t)
+			ctx := context.Background()
+			_, err := db.client.CreateTable(ctx, &dynamodb.CreateTableInput{
+				TableName:            aws.String(db.tableName),
+				AttributeDefinitions: tt.attrs,
+				KeySchema:            tt.keys,
+				BillingMode:          types.BillingModePayPerRequest,
+			})
+			if err != nil {
+				t.Fatalf("CreateTable: %v", err)
+			}
+			waiter := dynamodb.NewTableExistsWaiter(db.client)
+			if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(db.tableName)}, time.Minute); err != nil {
+				t.Fatalf("table not ready: %v", err)
+			}
+
+			var schemaErr *SchemaError
+			if err := db.CheckSchema(ctx); !errors.As(err, &schemaErr) || schemaErr.Missing || schemaErr.Problem == "" {
+				t.Fatalf("CheckSchema err = %v, want a schema problem", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+// internal/diag/diag.go
+
+package diag
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"cookieBot/internal/audit"
+	"cookieBot/internal/secrets"
+)
+
+const (
+	// DefaultLogTail 은 번들에 넣는 로그 끝부분의 기본 크기입니다.
+	DefaultLogTail = 1 << 20
+	// DefaultAuditEntries 는 번들에 넣는 최근 감사 로그 항목의 기본 개수입니다.
+	DefaultAuditEntries = 200
+
+	redacted = "[redacted]"
+)
+
+// 설정에 값이 없어도 텍스트에서 가릴 패턴 (AWS 키, JSON 의 민감한 필드)
+var (
+	awsKeyPattern    = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+	jsonFieldPattern = regexp.MustCompile(`(?i)("[^"]*(password|passphrase|secret|token|credential)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// Options 는 진단 번들에 넣을 내용입니다. 비어 있는 항목은 건너뜁니다.
+type Options struct {
+	// LogPath 의 마지막 LogTail 바이트를 logs/app.log 로 넣습니다.
+	LogPath string
+	LogTail int64
+	// ConfigPath 의 설정을 민감한 값을 가린 뒤 config.json 으로 넣습니다.
+	ConfigPath string
+	// Secrets 는 설정의 "secret://" 참조를 푸는 저장소입니다. 참조는 그대로 두고, 푼 값을 다른 파일에서 가립니다.
+	Secrets secrets.Store
+	// Versions 는 구성 요소 버전입니다. 빌드 정보(Go, 모듈 버전)는 자동으로 추가됩니다.
+	Versions map[string]string
+	// Health 는 상태 검사 결과로 health.json 에 그대로 들어갑니다.
+	Health interface{}
+	// Audit 의 최근 AuditEntries 개 항목을 audit.jsonl 로 넣습니다.
+	Audit        *audit.Log
+	AuditEntries int
+}
+
+// Manifest 는 번들에 들어간 파일과 생성 정보입니다.
+type Manifest struct {
+	CreatedAt time.Time `json:"created_at"`
+	Files     []string  `json:"files"`
+	// Skipped 는 넣지 못한 항목과 이유입니다.
+	Skipped map[string]string `json:"skipped,omitempty"`
+}
+
+// WriteFile 은 진단 번들을 path 에 zip 으로 씁니다.
+func WriteFile(path string, opts Options) (*Manifest, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), ".diag-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create diagnostics bundle: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	manifest, err := Write(f, opts)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, fmt.Errorf("failed to write diagnostics bundle: %w", err)
+	}
+	return manifest, nil
+}
+
+// Write 는 진단 번들을 w 에 zip 으로 씁니다. 모든 텍스트는 scrubber 를 거치므로 설정에 들어 있던
+// 비밀 값이 로그 등 다른 파일에 찍혀 있어도 가려집니다.
+func Write(w io.Writer, opts Options) (*Manifest, error) {
+	if opts.LogTail <= 0 {
+		opts.LogTail = DefaultLogTail
+	}
+	if opts.AuditEntries <= 0 {
+		opts.AuditEntries = DefaultAuditEntries
+	}
+
+	b := &bundle{zw: zip.NewWriter(w), manifest: &Manifest{CreatedAt: time.Now().UTC(), Skipped: map[string]string{}}}
+
+	if opts.ConfigPath != "" {
+		config, values, err := redactConfig(opts.ConfigPath, opts.Secrets)
+		if err != nil {
+			b.skip("config.json", err)
+		} else {
+			b.scrub.values = values
+			b.add("config.json", config)
+		}
+	}
+
+	if opts.LogPath != "" {
+		if data, err := tail(opts.LogPath, opts.LogTail); err != nil {
+			b.skip("logs/app.log", err)
+		} else {
+			b.add("logs/app.log", data)
+		}
+	}
+
+	b.addJSON("versions.json", versions(opts.Versions))
+	b.addJSON("system.json", systemInfo())
+	if opts.Health != nil {
+		b.addJSON("health.json", opts.Health)
+	}
+
+	if opts.Audit != nil {
+		if data, err := auditTail(opts.Audit, opts.AuditEntries); err != nil {
+			b.skip("audit.jsonl", err)
+		} else {
+			b.add("audit.jsonl", data)
+		}
+	}
+
+	if len(b.manifest.Skipped) == 0 {
+		b.manifest.Skipped = nil
+	}
+	b.addJSON("manifest.json", b.manifest)
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err := b.zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish diagnostics bundle: %w", err)
+	}
+	return b.manifest, nil
+}
+
+// bundle 은 zip 에 파일을 추가하며 첫 쓰기 오류를 기억합니다.
+type bundle struct {
+	zw       *zip.Writer
+	manifest *Manifest
+	scrub    scrubber
+	err      error
+}
+
+func (b *bundle) add(name string, data []byte) {
+	if b.err != nil {
+		return
+	}
+	fw, err := b.zw.Create(name)
+	if err == nil {
+		_, err = fw.Write(b.scrub.apply(data))
+	}
+	if err != nil {
+		b.err = fmt.Errorf("failed to add %s to diagnostics bundle: %w", name, err)
+		return
+	}
+	if name != "manifest.json" {
+		b.manifest.Files = append(b.manifest.Files, name)
+	}
+}
+
+func (b *bundle) addJSON(name string, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		b.skip(name, err)
+		return
+	}
+	b.add(name, data)
+}
+
+func (b *bundle) skip(name string, err error) {
+	b.manifest.Skipped[name] = err.Error()
+}
+
+// scrubber 는 설정에서 찾은 비밀 값과 알려진 패턴을 가립니다.
+type scrubber struct {
+	values []string
+}
+
+func (s scrubber) apply(data []byte) []byte {
+	text := string(data)
+	for _, v := range s.values {
+		text = strings.ReplaceAll(text, v, redacted)
+	}
+	text = awsKeyPattern.ReplaceAllString(text, redacted)
+	text = jsonFieldPattern.ReplaceAllStringFunc(text, func(m string) string {
+		sub := jsonFieldPattern.FindStringSubmatch(m)
+		// 비밀 저장소 참조는 값이 아니므로 남깁니다.
+		if strings.HasPrefix(m[len(sub[1]):], `"`+secrets.RefPrefix) {
+			return m
+		}
+		return sub[1] + `"` + redacted + `"`
+	})
+	return []byte(text)
+}
+
+// redactConfig 는 설정 JSON 에서 민감한 이름의 값을 가리고, 가린 원래 값을 함께 반환합니다.
+// "secret://" 참조는 값이 아니므로 그대로 두되, store 에서 풀 수 있으면 푼 값을 함께 반환합니다.
+func redactConfig(path string, store secrets.Store) ([]byte, []string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var config interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	var values []string
+	config = redactValue("", config, store, &values)
+	out, err := json.MarshalIndent(config, "", "  ")
+	return out, values, err
+}
+
+func redactValue(key string, v interface{}, store secrets.Store, values *[]string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = redactValue(k, child, store, values)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactValue(key, child, store, values)
+		}
+		return v
+	case string:
+		if _, ok := secrets.ParseRef(v); ok {
+			// 잠겨 있는 등으로 풀 수 없는 값은 앱도 읽지 못했으므로 건너뜁니다.
+			if secret, err := secrets.Resolve(store, v); err == nil && secret != "" {
+				*values = append(*values, secret)
+			}
+			return v
+		}
+		if v == "" || !audit.SensitiveKey(key) {
+			return v
+		}
+		*values = append(*values, v)
+		return redacted
+	}
+	return v
+}
+
+// tail 은 파일의 마지막 n 바이트를 읽고, 잘린 첫 줄은 버립니다.
+func tail(path string, n int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := info.Size() - n
+	if offset < 0 {
+		offset = 0
+	}
+	data := make([]byte, info.Size()-offset)
+	if _, err := f.ReadAt(data, offset); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if offset > 0 {
+		if i := strings.IndexByte(string(data), '\n'); i >= 0 {
+			data = data[i+1:]
+		}
+	}
+	return data, nil
+}
+
+func auditTail(l *audit.Log, n int) ([]byte, error) {
+	var sb strings.Builder
+	if _, err := l.Export(&sb, audit.Query{Limit: n}); err != nil {
+		return nil, err
+	}
+	return []byte(sb.String()), nil
+}
+
+// versions 는 빌드 정보와 extra 를 합칩니다.
+func versions(extra map[string]string) map[string]string {
+	out := map[string]string{"go": runtime.Version()}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		out["app"] = info.Main.Version
+		for _, s := range info.Settings {
+			if s.Key == "vcs.revision" || s.Key == "vcs.time" || s.Key == "vcs.modified" {
+				out[s.Key] = s.Value
+			}
+		}
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/wailsapp/wails/v2" {
+				out["wails"] = dep.Version
+			}
+		}
+	}
+	for k, v := range extra {
+		out[k] = v
+	}
+	return out
+}
+
+// SystemInfo 는 OS 정보입니다. 호스트 이름과 사용자 이름은 넣지 않습니다.
+type SystemInfo struct {
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+	OSVersion string `json:"os_version,omitempty"`
+	CPUs      int    `json:"cpus"`
+	// Env 는 동작에 영향을 주는 환경 변수가 설정되어 있는지 여부만 기록합니다.
+	Env map[string]bool `json:"env"`
+}
+
+func systemInfo() SystemInfo {
+	env := map[string]bool{}
+	for _, key := range []string{"COOKIEBOT_CONFIG", "COOKIEBOT_PASSPHRASE", "HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY"} {
+		_, env[key] = os.LookupEnv(key)
+	}
+	return SystemInfo{
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		OSVersion: osVersion(),
+		CPUs:      runtime.NumCPU(),
+		Env:       env,
+	}
+}
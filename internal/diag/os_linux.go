@@ -0,0 +1,26 @@
+// internal/diag/os_linux.go
+
+package diag
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// osVersion 은 /etc/os-release 의 PRETTY_NAME 을 반환합니다.
+func osVersion() string {
+	f, err := os.Open("/etc/os-release")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), "PRETTY_NAME="); ok {
+			return strings.Trim(value, `"`)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,10 @@
+// internal/diag/os_other.go
+
+//go:build !linux && !windows
+
+package diag
+
+// osVersion 은 지원하지 않는 플랫폼에서는 빈 문자열을 반환합니다.
+func osVersion() string {
+	return ""
+}
@@ -0,0 +1,15 @@
+// internal/diag/os_windows.go
+
+package diag
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+// osVersion 은 Windows 버전과 빌드 번호를 반환합니다.
+func osVersion() string {
+	v := windows.RtlGetVersion()
+	return fmt.Sprintf("Windows %d.%d.%d", v.MajorVersion, v.MinorVersion, v.BuildNumber)
+}
@@ -0,0 +1,58 @@
+// internal/health/checks.go
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// DiskSpace 는 path 가 있는 디스크의 남은 공간을 검사합니다. warnBelow/failBelow 는 바이트 단위입니다.
+func DiskSpace(name, path string, warnBelow, failBelow uint64) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) Outcome {
+			free, err := freeSpace(path)
+			if err != nil {
+				return Warn(fmt.Sprintf("could not read free space of %s: %v", path, err), "")
+			}
+			msg := fmt.Sprintf("%s free on %s", formatBytes(free), path)
+			switch {
+			case free < failBelow:
+				return Fail(msg, "디스크 공간을 확보하세요. 프로필 실행과 다운로드가 실패할 수 있습니다.")
+			case free < warnBelow:
+				return Warn(msg, "디스크 공간이 부족해지고 있습니다. 사용하지 않는 파일을 정리하세요.")
+			}
+			return OK(msg)
+		},
+	}
+}
+
+// Writable 은 path 파일을 추가 모드로 열 수 있는지 검사합니다. 파일이 없으면 만들어 봅니다.
+func Writable(name, path, hint string) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) Outcome {
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+			if err != nil {
+				return Fail(fmt.Sprintf("%s is not writable: %v", path, err), hint)
+			}
+			f.Close()
+			return OK(path + " is writable")
+		},
+	}
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,16 @@
+// internal/health/disk_unix.go
+
+//go:build !windows
+
+package health
+
+import "syscall"
+
+// freeSpace 는 path 가 있는 파일 시스템에서 일반 사용자가 쓸 수 있는 남은 바이트 수를 반환합니다.
+func freeSpace(path string) (uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	return uint64(st.Bavail) * uint64(st.Bsize), nil
+}
@@ -0,0 +1,18 @@
+// internal/health/disk_windows.go
+
+package health
+
+import "golang.org/x/sys/windows"
+
+// freeSpace 는 path 가 있는 드라이브에서 현재 사용자가 쓸 수 있는 남은 바이트 수를 반환합니다.
+func freeSpace(path string) (uint64, error) {
+	p, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var free uint64
+	if err := windows.GetDiskFreeSpaceEx(p, &free, nil, nil); err != nil {
+		return 0, err
+	}
+	return free, nil
+}
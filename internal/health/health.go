@@ -0,0 +1,202 @@
+// internal/health/health.go
+
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 검사 결과 상태. 보고서 전체 상태는 가장 나쁜 검사 결과를 따릅니다.
+const (
+	StatusOK   = "ok"
+	StatusWarn = "warn"
+	StatusFail = "fail"
+)
+
+// DefaultTimeout 은 Check.Timeout 이 없을 때 검사 하나에 허용하는 시간입니다.
+const DefaultTimeout = 5 * time.Second
+
+// Outcome 은 검사 함수가 돌려주는 결과입니다. Hint 는 문제가 있을 때 사용자가 할 일을 알려 줍니다.
+type Outcome struct {
+	Status  string
+	Message string
+	Hint    string
+}
+
+// OK 는 정상 결과를 만듭니다.
+func OK(message string) Outcome {
+	return Outcome{Status: StatusOK, Message: message}
+}
+
+// Warn 은 동작은 하지만 확인이 필요한 결과를 만듭니다.
+func Warn(message, hint string) Outcome {
+	return Outcome{Status: StatusWarn, Message: message, Hint: hint}
+}
+
+// Fail 은 기능을 쓸 수 없는 결과를 만듭니다.
+func Fail(message, hint string) Outcome {
+	return Outcome{Status: StatusFail, Message: message, Hint: hint}
+}
+
+// Check 는 등록된 검사 하나입니다.
+type Check struct {
+	Name    string
+	Timeout time.Duration
+	Run     func(ctx context.Context) Outcome
+}
+
+// Result 는 검사 하나의 실행 결과입니다.
+type Result struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Message   string `json:"message"`
+	Hint      string `json:"hint,omitempty"`
+}
+
+// Report 는 모든 검사 결과를 등록 순서대로 모은 것입니다.
+type Report struct {
+	Status    string    `json:"status"`
+	CheckedAt time.Time `json:"checked_at"`
+	Checks    []Result  `json:"checks"`
+}
+
+// Registry 는 검사를 등록받아 동시에 실행하고 마지막 보고서를 보관합니다.
+type Registry struct {
+	mu       sync.Mutex
+	checks   []Check
+	last     Report
+	onChange func(Report)
+}
+
+// NewRegistry 는 빈 Registry 를 만듭니다.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register 는 검사를 추가합니다.
+func (r *Registry) Register(c Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, c)
+}
+
+// OnChange 는 검사 상태가 바뀌었을 때 호출할 함수를 등록합니다. 메시지만 바뀐 경우(남은 디스크 용량 등)는 알리지 않습니다.
+func (r *Registry) OnChange(fn func(Report)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onChange = fn
+}
+
+// Last 는 마지막으로 실행한 보고서를 반환합니다. 아직 실행하지 않았으면 빈 보고서입니다.
+func (r *Registry) Last() Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
+
+// Run 은 모든 검사를 동시에 실행해 보고서를 만듭니다. 시간 안에 끝나지 않은 검사는 실패로 기록됩니다.
+func (r *Registry) Run(ctx context.Context) Report {
+	r.mu.Lock()
+	checks := append([]Check(nil), r.checks...)
+	r.mu.Unlock()
+
+	report := Report{Status: StatusOK, CheckedAt: time.Now().UTC(), Checks: make([]Result, len(checks))}
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c Check) {
+			defer wg.Done()
+			report.Checks[i] = run(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	for _, res := range report.Checks {
+		if rank(res.Status) > rank(report.Status) {
+			report.Status = res.Status
+		}
+	}
+
+	r.mu.Lock()
+	changed := !sameResults(r.last, report)
+	r.last = report
+	onChange := r.onChange
+	r.mu.Unlock()
+
+	if changed && onChange != nil {
+		onChange(report)
+	}
+	return report
+}
+
+// Watch 는 ctx 가 취소될 때까지 interval 마다 Run 합니다. 바뀐 결과는 OnChange 로 알립니다.
+func (r *Registry) Watch(ctx context.Context, interval time.Duration) {
+	r.Run(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Run(ctx)
+		}
+	}
+}
+
+func run(ctx context.Context, c Check) Result {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan Outcome, 1)
+	go func() { done <- c.Run(ctx) }()
+
+	var out Outcome
+	select {
+	case out = <-done:
+	case <-ctx.Done():
+		out = Fail("check timed out after "+timeout.String(), "")
+	}
+	if out.Status == "" {
+		out.Status = StatusOK
+	}
+	return Result{
+		Name:      c.Name,
+		Status:    out.Status,
+		LatencyMS: time.Since(start).Milliseconds(),
+		Message:   out.Message,
+		Hint:      out.Hint,
+	}
+}
+
+func rank(status string) int {
+	switch status {
+	case StatusFail:
+		return 2
+	case StatusWarn:
+		return 1
+	}
+	return 0
+}
+
+// sameResults 는 검사별 상태만 비교합니다.
+func sameResults(a, b Report) bool {
+	if a.Status != b.Status || len(a.Checks) != len(b.Checks) {
+		return false
+	}
+	for i := range a.Checks {
+		x, y := a.Checks[i], b.Checks[i]
+		if x.Name != y.Name || x.Status != y.Status {
+			return false
+		}
+	}
+	return true
+}
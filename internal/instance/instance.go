@@ -0,0 +1,122 @@
+// internal/instance/instance.go
+
+// Package instance 는 앱이 한 번만 실행되도록 보장합니다. 첫 번째 인스턴스는 PID 가 기록된
+// lockfile 을 잠그고 로컬 소켓으로 대기하며, 두 번째 실행은 자신의 인자를 그 소켓으로 전달한 뒤 종료합니다.
+package instance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrAlreadyRunning 은 다른 인스턴스가 이미 lock 을 가지고 있을 때 반환됩니다.
+var ErrAlreadyRunning = errors.New("another instance is already running")
+
+const dialTimeout = 3 * time.Second
+
+// Lock 은 첫 번째 인스턴스가 보유하는 lockfile 과 소켓 리스너입니다.
+type Lock struct {
+	name     string
+	file     *os.File
+	listener net.Listener
+}
+
+// message 는 두 번째 인스턴스가 첫 번째 인스턴스로 보내는 내용입니다.
+type message struct {
+	PID  int      `json:"pid"`
+	Args []string `json:"args"`
+}
+
+// Acquire 는 name 에 대한 단일 인스턴스 lock 을 잡습니다. 이미 실행 중인 인스턴스가 있으면
+// ErrAlreadyRunning 을 감싼 오류(실행 중인 PID 포함)를 반환합니다.
+func Acquire(name string) (*Lock, error) {
+	dir := runtimeDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+
+	path := filepath.Join(dir, name+".lock")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	if err := lockFile(file); err != nil {
+		file.Close()
+		if errors.Is(err, ErrAlreadyRunning) {
+			return nil, fmt.Errorf("%w (pid %s)", ErrAlreadyRunning, readPID(path))
+		}
+		return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+
+	if err := file.Truncate(0); err == nil {
+		file.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0)
+	}
+
+	listener, err := listen(name)
+	if err != nil {
+		unlockFile(file)
+		file.Close()
+		return nil, fmt.Errorf("failed to listen for other instances: %w", err)
+	}
+
+	return &Lock{name: name, file: file, listener: listener}, nil
+}
+
+// Serve 는 두 번째 인스턴스가 보낸 인자를 handler 로 전달합니다. Release 될 때까지 블록됩니다.
+func (l *Lock) Serve(handler func(args []string)) {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		var msg message
+		conn.SetReadDeadline(time.Now().Add(dialTimeout))
+		err = json.NewDecoder(conn).Decode(&msg)
+		conn.Close()
+		if err != nil {
+			continue
+		}
+		handler(msg.Args)
+	}
+}
+
+// Release 는 소켓을 닫고 lock 을 해제합니다.
+func (l *Lock) Release() error {
+	l.listener.Close()
+	cleanupSocket(l.name)
+	l.file.Truncate(0)
+	if err := unlockFile(l.file); err != nil {
+		l.file.Close()
+		return err
+	}
+	return l.file.Close()
+}
+
+// Forward 는 이미 실행 중인 인스턴스로 args 를 전달합니다.
+func Forward(name string, args []string) error {
+	conn, err := dial(name, dialTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to running instance: %w", err)
+	}
+	defer conn.Close()
+
+	conn.SetWriteDeadline(time.Now().Add(dialTimeout))
+	return json.NewEncoder(conn).Encode(message{PID: os.Getpid(), Args: args})
+}
+
+func readPID(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil || len(strings.TrimSpace(string(data))) == 0 {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(data))
+}
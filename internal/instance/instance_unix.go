@@ -0,0 +1,53 @@
+// internal/instance/instance_unix.go
+
+//go:build !windows
+
+package instance
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// runtimeDir 는 사용자별 lockfile/소켓 디렉토리입니다.
+func runtimeDir() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), "cookiebot-"+strconv.Itoa(os.Getuid()))
+}
+
+func lockFile(f *os.File) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return ErrAlreadyRunning
+	}
+	return err
+}
+
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
+
+func socketPath(name string) string {
+	return filepath.Join(runtimeDir(), name+".sock")
+}
+
+// listen 은 lock 을 가진 상태에서만 호출되므로 남아 있는 소켓 파일은 이전 실행의 잔여물입니다.
+func listen(name string) (net.Listener, error) {
+	os.Remove(socketPath(name))
+	return net.Listen("unix", socketPath(name))
+}
+
+func dial(name string, timeout time.Duration) (net.Conn, error) {
+	return net.DialTimeout("unix", socketPath(name), timeout)
+}
+
+func cleanupSocket(name string) {
+	os.Remove(socketPath(name))
+}
@@ -0,0 +1,56 @@
+// internal/instance/instance_windows.go
+
+package instance
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// 잠금 범위를 파일 내용 밖에 두어 다른 프로세스가 PID 를 읽을 수 있도록 합니다.
+const lockOffsetHigh = 0x7FFFFFFF
+
+// runtimeDir 는 사용자별 lockfile/소켓 디렉토리입니다. (%LOCALAPPDATA%\cookieBot)
+func runtimeDir() string {
+	if dir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(dir, "cookieBot")
+	}
+	return os.TempDir()
+}
+
+func lockFile(f *os.File) error {
+	ol := &windows.Overlapped{OffsetHigh: lockOffsetHigh}
+	err := windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, ol)
+	if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+		return ErrAlreadyRunning
+	}
+	return err
+}
+
+func unlockFile(f *os.File) error {
+	ol := &windows.Overlapped{OffsetHigh: lockOffsetHigh}
+	return windows.UnlockFileEx(windows.Handle(f.Fd()), 0, 1, 0, ol)
+}
+
+// Windows 10 1803 이상은 AF_UNIX 소켓을 지원하므로 같은 방식으로 통신합니다.
+func socketPath(name string) string {
+	return filepath.Join(runtimeDir(), name+".sock")
+}
+
+func listen(name string) (net.Listener, error) {
+	os.Remove(socketPath(name))
+	return net.Listen("unix", socketPath(name))
+}
+
+func dial(name string, timeout time.Duration) (net.Conn, error) {
+	return net.DialTimeout("unix", socketPath(name), timeout)
+}
+
+func cleanupSocket(name string) {
+	os.Remove(socketPath(name))
+}
@@ -0,0 +1,129 @@
+// internal/secrets/file.go
+
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"cookieBot/utils"
+)
+
+// FileStore 는 OS 키링을 쓸 수 없을 때 사용하는 암호화 파일 저장소입니다.
+// 모든 값을 하나의 JSON 으로 묶어 KeyFunc 의 키로 AES-256-GCM 암호화해 저장합니다.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	key  KeyFunc
+}
+
+// NewFileStore 는 path 의 암호화 파일 저장소를 만듭니다. 파일은 처음 Set 할 때 생성됩니다.
+func NewFileStore(path string, key KeyFunc) *FileStore {
+	return &FileStore{path: path, key: key}
+}
+
+func (f *FileStore) Get(name string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key, err := f.key()
+	if err != nil {
+		return "", err
+	}
+	values, err := f.load(key)
+	if err != nil {
+		return "", err
+	}
+	v, ok := values[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v, nil
+}
+
+func (f *FileStore) Set(name, value string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	return f.update(func(values map[string]string) error {
+		values[name] = value
+		return nil
+	})
+}
+
+func (f *FileStore) Delete(name string) error {
+	return f.update(func(values map[string]string) error {
+		if _, ok := values[name]; !ok {
+			return ErrNotFound
+		}
+		delete(values, name)
+		return nil
+	})
+}
+
+// Rekey 는 파일을 oldKey 로 열어 newKey 로 다시 암호화합니다. 마스터 패스프레이즈를 바꿀 때 호출됩니다.
+func (f *FileStore) Rekey(oldKey, newKey []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	values, err := f.load(oldKey)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return f.save(newKey, values)
+}
+
+func (f *FileStore) update(fn func(map[string]string) error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key, err := f.key()
+	if err != nil {
+		return err
+	}
+	values, err := f.load(key)
+	if err != nil {
+		return err
+	}
+	if err := fn(values); err != nil {
+		return err
+	}
+	return f.save(key, values)
+}
+
+// load 는 파일을 복호화합니다. 파일이 없으면 빈 맵을 반환합니다.
+func (f *FileStore) load(key []byte) (map[string]string, error) {
+	values := map[string]string{}
+	sealed, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secrets file: %w", err)
+	}
+	plain, err := utils.Open(key, sealed)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(plain, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse secrets file: %w", err)
+	}
+	return values, nil
+}
+
+func (f *FileStore) save(key []byte, values map[string]string) error {
+	plain, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	sealed, err := utils.Seal(key, plain)
+	if err != nil {
+		return err
+	}
+	if err := utils.WriteFileAtomic(f.path, sealed, 0600); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// internal/secrets/file_test.go
+
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cookieBot/utils"
+)
+
+// staticKey 는 key 를 돌려주는 KeyFunc 입니다. key 가 nil 이면 잠긴 상태처럼 err 를 반환합니다.
+func staticKey(key []byte, err error) KeyFunc {
+	return func() ([]byte, error) {
+		if key == nil {
+			return nil, err
+		}
+		return key, nil
+	}
+}
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func TestFileStoreSetGetDelete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	f := NewFileStore(path, staticKey(testKey(1), nil))
+
+	if _, err := f.Get("aws/default"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get before the file exists err = %v, want ErrNotFound", err)
+	}
+	if err := f.Set("aws/default", "s3cr3t"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := f.Set("aws/other", "second"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := f.Set("", "x"); err == nil {
+		t.Fatalf("Set accepted an empty name")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if bytes.Contains(data, []byte("s3cr3t")) || bytes.Contains(data, []byte("aws/default")) {
+		t.Fatalf("secrets file is not encrypted: %q", data)
+	}
+
+	// 같은 키로 새로 연 저장소에서도 읽혀야 합니다.
+	reopened := NewFileStore(path, staticKey(testKey(1), nil))
+	if v, err := reopened.Get("aws/default"); err != nil || v != "s3cr3t" {
+		t.Fatalf("Get = %q, %v; want s3cr3t", v, err)
+	}
+
+	if err := f.Delete("aws/default"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := f.Get("aws/default"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get after Delete err = %v, want ErrNotFound", err)
+	}
+	if err := f.Delete("aws/default"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("second Delete err = %v, want ErrNotFound", err)
+	}
+	if v, err := f.Get("aws/other"); err != nil || v != "second" {
+		t.Fatalf("Get(aws/other) = %q, %v; want it untouched", v, err)
+	}
+}
+
+func TestFileStoreWrongKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	if err := NewFileStore(path, staticKey(testKey(1), nil)).Set("aws/default", "s3cr3t"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	before, _ := os.ReadFile(path)
+
+	wrong := NewFileStore(path, staticKey(testKey(2), nil))
+	if _, err := wrong.Get("aws/default"); !errors.Is(err, utils.ErrDecrypt) {
+		t.Fatalf("Get with the wrong key err = %v, want ErrDecrypt", err)
+	}
+	// 잘못된 키로 쓰면 기존 파일을 덮어쓰지 않아야 합니다.
+	if err := wrong.Set("aws/other", "x"); !errors.Is(err, utils.ErrDecrypt) {
+		t.Fatalf("Set with the wrong key err = %v, want ErrDecrypt", err)
+	}
+	if err := wrong.Delete("aws/default"); !errors.Is(err, utils.ErrDecrypt) {
+		t.Fatalf("Delete with the wrong key err = %v, want ErrDecrypt", err)
+	}
+	if after, _ := os.ReadFile(path); !bytes.Equal(before, after) {
+		t.Fatalf("secrets file changed after writes with the wrong key")
+	}
+
+	// 앱이 잠겨 키를 얻지 못하면 그 오류를 그대로 돌려줍니다.
+	errLocked := errors.New("locked")
+	locked := NewFileStore(path, staticKey(nil, errLocked))
+	if _, err := locked.Get("aws/default"); !errors.Is(err, errLocked) {
+		t.Fatalf("Get while locked err = %v, want the key error", err)
+	}
+	if err := locked.Set("aws/default", "x"); !errors.Is(err, errLocked) {
+		t.Fatalf("Set while locked err = %v, want the key error", err)
+	}
+}
+
+func TestFileStoreRekey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	f := NewFileStore(path, staticKey(testKey(1), nil))
+	if err := f.Set("aws/default", "s3cr3t"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if err := f.Rekey(testKey(9), testKey(2)); !errors.Is(err, utils.ErrDecrypt) {
+		t.Fatalf("Rekey with the wrong old key err = %v, want ErrDecrypt", err)
+	}
+	if err := f.Rekey(testKey(1), testKey(2)); err != nil {
+		t.Fatalf("Rekey: %v", err)
+	}
+
+	if _, err := f.Get("aws/default"); !errors.Is(err, utils.ErrDecrypt) {
+		t.Fatalf("Get with the old key after Rekey err = %v, want ErrDecrypt", err)
+	}
+	rekeyed := NewFileStore(path, staticKey(testKey(2), nil))
+	if v, err := rekeyed.Get("aws/default"); err != nil || v != "s3cr3t" {
+		t.Fatalf("Get with the new key = %q, %v; want s3cr3t", v, err)
+	}
+
+	// 파일이 없으면 다시 암호화할 것이 없습니다.
+	empty := NewFileStore(filepath.Join(t.TempDir(), "secrets.enc"), staticKey(testKey(1), nil))
+	if err := empty.Rekey(testKey(1), testKey(2)); err != nil {
+		t.Fatalf("Rekey without a file: %v", err)
+	}
+	if _, err := os.Stat(empty.path); !os.IsNotExist(err) {
+		t.Fatalf("Rekey created a file for an empty store")
+	}
+}
@@ -0,0 +1,208 @@
+// internal/secrets/keyring_linux.go
+
+package secrets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// freedesktop Secret Service (gnome-keyring, KWallet 등) D-Bus 이름
+const (
+	secretDest        = "org.freedesktop.secrets"
+	secretPath        = dbus.ObjectPath("/org/freedesktop/secrets")
+	defaultCollection = dbus.ObjectPath("/org/freedesktop/secrets/aliases/default")
+	serviceIface      = "org.freedesktop.Secret.Service"
+	collectionIface   = "org.freedesktop.Secret.Collection"
+	itemIface         = "org.freedesktop.Secret.Item"
+	promptIface       = "org.freedesktop.Secret.Prompt"
+	sessionIface      = "org.freedesktop.Secret.Session"
+	// 프롬프트가 필요 없음을 나타내는 경로
+	noPrompt = dbus.ObjectPath("/")
+	// 키링 잠금 해제 창에 응답을 기다리는 최대 시간
+	promptTimeout = 2 * time.Minute
+)
+
+// dbusSecret 은 Secret Service 의 (oayays) Secret 구조체입니다.
+type dbusSecret struct {
+	Session     dbus.ObjectPath
+	Parameters  []byte
+	Value       []byte
+	ContentType string
+}
+
+// Keyring 은 Secret Service(libsecret) 를 사용하는 OS 키링 저장소입니다.
+// 항목은 service/username 속성으로 찾으므로 secret-tool 로도 확인할 수 있습니다.
+type Keyring struct {
+	service string
+	conn    *dbus.Conn
+}
+
+// NewKeyring 은 세션 버스의 Secret Service 에 연결합니다. 사용할 수 없으면 ErrUnsupported 를 반환합니다.
+func NewKeyring(service string) (*Keyring, error) {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	k := &Keyring{service: service, conn: conn}
+	session, err := k.openSession()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	k.closeSession(session)
+	return k, nil
+}
+
+func (k *Keyring) Get(name string) (string, error) {
+	item, err := k.find(name)
+	if err != nil {
+		return "", err
+	}
+	session, err := k.openSession()
+	if err != nil {
+		return "", err
+	}
+	defer k.closeSession(session)
+
+	var secret dbusSecret
+	if err := k.conn.Object(secretDest, item).Call(itemIface+".GetSecret", 0, session).Store(&secret); err != nil {
+		return "", fmt.Errorf("failed to read keyring item: %w", err)
+	}
+	return string(secret.Value), nil
+}
+
+func (k *Keyring) Set(name, value string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	if err := k.unlock(defaultCollection); err != nil {
+		return err
+	}
+	session, err := k.openSession()
+	if err != nil {
+		return err
+	}
+	defer k.closeSession(session)
+
+	props := map[string]dbus.Variant{
+		itemIface + ".Label":      dbus.MakeVariant(k.service + ": " + name),
+		itemIface + ".Attributes": dbus.MakeVariant(k.attributes(name)),
+	}
+	secret := dbusSecret{Session: session, Value: []byte(value), ContentType: "text/plain; charset=utf8"}
+
+	var item, prompt dbus.ObjectPath
+	err = k.conn.Object(secretDest, defaultCollection).
+		Call(collectionIface+".CreateItem", 0, props, secret, true).Store(&item, &prompt)
+	if err != nil {
+		return fmt.Errorf("failed to write keyring item: %w", err)
+	}
+	return k.prompt(prompt)
+}
+
+func (k *Keyring) Delete(name string) error {
+	item, err := k.find(name)
+	if err != nil {
+		return err
+	}
+	var prompt dbus.ObjectPath
+	if err := k.conn.Object(secretDest, item).Call(itemIface+".Delete", 0).Store(&prompt); err != nil {
+		return fmt.Errorf("failed to delete keyring item: %w", err)
+	}
+	return k.prompt(prompt)
+}
+
+func (k *Keyring) attributes(name string) map[string]string {
+	return map[string]string{"service": k.service, "username": name}
+}
+
+// find 는 이름에 해당하는 항목을 찾고, 잠겨 있으면 잠금을 풉니다.
+func (k *Keyring) find(name string) (dbus.ObjectPath, error) {
+	var unlocked, locked []dbus.ObjectPath
+	err := k.conn.Object(secretDest, secretPath).
+		Call(serviceIface+".SearchItems", 0, k.attributes(name)).Store(&unlocked, &locked)
+	if err != nil {
+		return "", fmt.Errorf("failed to search keyring: %w", err)
+	}
+	switch {
+	case len(unlocked) > 0:
+		return unlocked[0], nil
+	case len(locked) > 0:
+		if err := k.unlock(locked[0]); err != nil {
+			return "", err
+		}
+		return locked[0], nil
+	}
+	return "", ErrNotFound
+}
+
+// unlock 은 항목이나 컬렉션의 잠금을 풉니다. 키링이 잠겨 있으면 OS 의 잠금 해제 창이 뜹니다.
+func (k *Keyring) unlock(path dbus.ObjectPath) error {
+	var unlocked []dbus.ObjectPath
+	var prompt dbus.ObjectPath
+	err := k.conn.Object(secretDest, secretPath).
+		Call(serviceIface+".Unlock", 0, []dbus.ObjectPath{path}).Store(&unlocked, &prompt)
+	if err != nil {
+		return fmt.Errorf("failed to unlock keyring: %w", err)
+	}
+	return k.prompt(prompt)
+}
+
+// prompt 는 Secret Service 가 요구한 프롬프트를 띄우고 사용자의 응답을 기다립니다.
+func (k *Keyring) prompt(path dbus.ObjectPath) error {
+	if path == noPrompt || path == "" {
+		return nil
+	}
+
+	match := []dbus.MatchOption{
+		dbus.WithMatchObjectPath(path),
+		dbus.WithMatchInterface(promptIface),
+		dbus.WithMatchMember("Completed"),
+	}
+	if err := k.conn.AddMatchSignal(match...); err != nil {
+		return fmt.Errorf("failed to watch keyring prompt: %w", err)
+	}
+	defer k.conn.RemoveMatchSignal(match...)
+	signals := make(chan *dbus.Signal, 1)
+	k.conn.Signal(signals)
+	defer k.conn.RemoveSignal(signals)
+
+	if err := k.conn.Object(secretDest, path).Call(promptIface+".Prompt", 0, "").Err; err != nil {
+		return fmt.Errorf("failed to show keyring prompt: %w", err)
+	}
+
+	timeout := time.After(promptTimeout)
+	for {
+		select {
+		case sig := <-signals:
+			if sig.Path != path || sig.Name != promptIface+".Completed" {
+				continue
+			}
+			if len(sig.Body) > 0 {
+				if dismissed, _ := sig.Body[0].(bool); dismissed {
+					return fmt.Errorf("keyring prompt was dismissed")
+				}
+			}
+			return nil
+		case <-timeout:
+			return fmt.Errorf("timed out waiting for keyring prompt")
+		}
+	}
+}
+
+// openSession 은 값을 평문으로 주고받는 세션을 엽니다. (세션 버스는 같은 사용자만 접근할 수 있습니다)
+func (k *Keyring) openSession() (dbus.ObjectPath, error) {
+	var output dbus.Variant
+	var session dbus.ObjectPath
+	err := k.conn.Object(secretDest, secretPath).
+		Call(serviceIface+".OpenSession", 0, "plain", dbus.MakeVariant("")).Store(&output, &session)
+	if err != nil {
+		return "", fmt.Errorf("failed to open keyring session: %w", err)
+	}
+	return session, nil
+}
+
+func (k *Keyring) closeSession(session dbus.ObjectPath) {
+	k.conn.Object(secretDest, session).Call(sessionIface+".Close", 0)
+}
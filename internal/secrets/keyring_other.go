@@ -0,0 +1,17 @@
+// internal/secrets/keyring_other.go
+
+//go:build !linux && !windows
+
+package secrets
+
+// Keyring 은 지원하지 않는 플랫폼에서는 항상 ErrUnsupported 를 반환합니다.
+type Keyring struct{}
+
+// NewKeyring 은 이 플랫폼에서 OS 키링을 지원하지 않으므로 ErrUnsupported 를 반환합니다.
+func NewKeyring(service string) (*Keyring, error) {
+	return nil, ErrUnsupported
+}
+
+func (k *Keyring) Get(name string) (string, error) { return "", ErrUnsupported }
+func (k *Keyring) Set(name, value string) error    { return ErrUnsupported }
+func (k *Keyring) Delete(name string) error        { return ErrUnsupported }
@@ -0,0 +1,127 @@
+// internal/secrets/keyring_windows.go
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	credTypeGeneric          = 1
+	credPersistLocalMachine  = 2
+	credMaxCredentialBlobLen = 5 * 512
+)
+
+var (
+	advapi32        = windows.NewLazySystemDLL("advapi32.dll")
+	procCredReadW   = advapi32.NewProc("CredReadW")
+	procCredWriteW  = advapi32.NewProc("CredWriteW")
+	procCredDeleteW = advapi32.NewProc("CredDeleteW")
+	procCredFree    = advapi32.NewProc("CredFree")
+)
+
+// credential 은 Win32 CREDENTIALW 구조체입니다.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        windows.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// Keyring 은 Windows 자격 증명 관리자를 사용하는 OS 키링 저장소입니다.
+// 항목은 "<service>:<name>" 이름의 일반 자격 증명으로 저장됩니다.
+type Keyring struct {
+	service string
+}
+
+// NewKeyring 은 자격 증명 관리자 API 를 확인합니다. 사용할 수 없으면 ErrUnsupported 를 반환합니다.
+func NewKeyring(service string) (*Keyring, error) {
+	if err := procCredReadW.Find(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	return &Keyring{service: service}, nil
+}
+
+func (k *Keyring) Get(name string) (string, error) {
+	target, err := windows.UTF16PtrFromString(k.target(name))
+	if err != nil {
+		return "", err
+	}
+	var cred *credential
+	r, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if r == 0 {
+		if errors.Is(err, windows.ERROR_NOT_FOUND) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("failed to read credential: %w", err)
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	if cred.CredentialBlobSize == 0 {
+		return "", nil
+	}
+	return string(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), nil
+}
+
+func (k *Keyring) Set(name, value string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	if len(value) > credMaxCredentialBlobLen {
+		return fmt.Errorf("secret %q is too large for the credential manager (%d bytes, max %d)", name, len(value), credMaxCredentialBlobLen)
+	}
+	target, err := windows.UTF16PtrFromString(k.target(name))
+	if err != nil {
+		return err
+	}
+	user, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(value)),
+		Persist:            credPersistLocalMachine,
+		UserName:           user,
+	}
+	if len(value) > 0 {
+		blob := []byte(value)
+		cred.CredentialBlob = &blob[0]
+	}
+	if r, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0); r == 0 {
+		return fmt.Errorf("failed to write credential: %w", err)
+	}
+	return nil
+}
+
+func (k *Keyring) Delete(name string) error {
+	target, err := windows.UTF16PtrFromString(k.target(name))
+	if err != nil {
+		return err
+	}
+	if r, _, err := procCredDeleteW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0); r == 0 {
+		if errors.Is(err, windows.ERROR_NOT_FOUND) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+	return nil
+}
+
+func (k *Keyring) target(name string) string {
+	return k.service + ":" + name
+}
@@ -0,0 +1,50 @@
+// internal/secrets/memory.go
+
+package secrets
+
+import "sync"
+
+// MemoryStore 는 프로세스 메모리에만 보관하는 저장소입니다. 테스트나 일회성 실행에 사용합니다.
+type MemoryStore struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+// NewMemoryStore 는 values 로 채운 메모리 저장소를 만듭니다. values 는 복사됩니다.
+func NewMemoryStore(values map[string]string) *MemoryStore {
+	m := &MemoryStore{values: make(map[string]string, len(values))}
+	for k, v := range values {
+		m.values[k] = v
+	}
+	return m
+}
+
+func (m *MemoryStore) Get(name string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.values[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v, nil
+}
+
+func (m *MemoryStore) Set(name, value string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[name] = value
+	return nil
+}
+
+func (m *MemoryStore) Delete(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.values[name]; !ok {
+		return ErrNotFound
+	}
+	delete(m.values, name)
+	return nil
+}
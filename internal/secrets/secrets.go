@@ -0,0 +1,74 @@
+// internal/secrets/secrets.go
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RefPrefix 는 설정 값이 비밀 저장소 참조임을 나타냅니다. (예: "secret://aws/default")
+const RefPrefix = "secret://"
+
+var (
+	// ErrNotFound 는 이름에 해당하는 비밀이 없을 때 반환됩니다.
+	ErrNotFound = errors.New("secret not found")
+	// ErrUnsupported 는 이 플랫폼에서 OS 키링을 쓸 수 없을 때 반환됩니다.
+	ErrUnsupported = errors.New("OS keyring is not available on this system")
+)
+
+// Store 는 이름으로 비밀 값을 저장하고 꺼내는 저장소입니다. 이름은 "aws/default" 처럼 "/" 로 구분합니다.
+type Store interface {
+	Get(name string) (string, error)
+	Set(name, value string) error
+	Delete(name string) error
+}
+
+// KeyFunc 는 암호화 파일 저장소가 사용할 AES-256 키를 반환합니다. 앱에서는 applock.Lock.Key 를 넘깁니다.
+type KeyFunc func() ([]byte, error)
+
+// ParseRef 는 "secret://name" 형식이면 name 과 true 를 반환합니다.
+func ParseRef(value string) (string, bool) {
+	if !strings.HasPrefix(value, RefPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, RefPrefix), true
+}
+
+// Resolve 는 value 가 참조이면 store 에서 값을 꺼내고, 아니면 value 를 그대로 반환합니다.
+func Resolve(store Store, value string) (string, error) {
+	name, ok := ParseRef(value)
+	if !ok {
+		return value, nil
+	}
+	if name == "" {
+		return "", fmt.Errorf("empty secret reference %q", value)
+	}
+	if store == nil {
+		return "", fmt.Errorf("secret reference %q needs a secrets store", value)
+	}
+	secret, err := store.Get(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", value, err)
+	}
+	return secret, nil
+}
+
+// Open 은 OS 키링을 쓸 수 있으면 키링을, 아니면 path 의 암호화 파일 저장소를 반환합니다.
+func Open(path string, key KeyFunc) Store {
+	if kr, err := NewKeyring(serviceName); err == nil {
+		return kr
+	}
+	return NewFileStore(path, key)
+}
+
+// 키링 항목을 구분하는 서비스 이름
+const serviceName = "cookieBot"
+
+func validName(name string) error {
+	if name == "" || strings.TrimSpace(name) != name {
+		return fmt.Errorf("invalid secret name %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// internal/secrets/secrets_test.go
+
+package secrets
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	store := NewMemoryStore(map[string]string{"aws/default": "s3cr3t"})
+
+	tests := []struct {
+		name    string
+		store   Store
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"plain value", store, "AKIAEXAMPLE", "AKIAEXAMPLE", false},
+		{"empty value", store, "", "", false},
+		{"plain value without store", nil, "AKIAEXAMPLE", "AKIAEXAMPLE", false},
+		{"reference", store, "secret://aws/default", "s3cr3t", false},
+		{"missing reference", store, "secret://aws/other", "", true},
+		{"empty reference", store, "secret://", "", true},
+		{"reference without store", nil, "secret://aws/default", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve(tt.store, tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Fatalf("Resolve(%q) = %q, %v; want %q (error %v)", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := Resolve(store, "secret://aws/other"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Resolve of a missing secret err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	initial := map[string]string{"a": "1"}
+	m := NewMemoryStore(initial)
+	initial["a"] = "changed"
+
+	if v, err := m.Get("a"); err != nil || v != "1" {
+		t.Fatalf("Get(a) = %q, %v; want the copied value", v, err)
+	}
+	if err := m.Set(" bad", "x"); err == nil {
+		t.Fatalf("Set accepted a name with spaces")
+	}
+	if err := m.Set("b", "2"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := m.Delete("b"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := m.Get("b"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get after Delete err = %v, want ErrNotFound", err)
+	}
+	if err := m.Delete("b"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("second Delete err = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,15 @@
+// internal/vm/runas_other.go
+
+//go:build !windows
+
+package vm
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// RunAs is only supported on Windows.
+func RunAs(path string) error {
+	return fmt.Errorf("running %s with admin privileges is not supported on %s", path, runtime.GOOS)
+}
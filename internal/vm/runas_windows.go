@@ -0,0 +1,25 @@
+// internal/vm/runas_windows.go
+
+package vm
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// RunAs launches the specified program with admin privileges.
+func RunAs(path string) error {
+	verbPtr, _ := syscall.UTF16PtrFromString("runas")
+	exePtr, _ := syscall.UTF16PtrFromString(path)
+	cwdPtr, _ := syscall.UTF16PtrFromString("")
+	argPtr, _ := syscall.UTF16PtrFromString("")
+
+	var showCmd int32 = 1 //SW_NORMAL
+
+	err := windows.ShellExecute(0, verbPtr, exePtr, argPtr, cwdPtr, showCmd)
+	if err != nil {
+		return err
+	}
+	return nil
+}
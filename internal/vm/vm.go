@@ -5,8 +5,17 @@ package vm
 import (
 	"context"
 	"go.uber.org/zap"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
+
+	"cookieBot/utils"
+)
+
+const (
+	vmrunPath  = "C:\\Program Files (x86)\\VMware\\VMware Workstation\\vmrun.exe"
+	vmwarePath = "C:\\Program Files (x86)\\VMware\\VMware Workstation\\vmware.exe"
 )
 
 type VM struct {
@@ -25,9 +34,7 @@ func VMMain(logger *zap.Logger) *VM {
 }
 
 func (v *VM) CheckVMWareStatus() VMWareStatus {
-	vmrunPath := "C:\\Program Files (x86)\\VMware\\VMware Workstation\\vmrun.exe"
-	vmwarePath := "C:\\Program Files (x86)\\VMware\\VMware Workstation\\vmware.exe"
-	vmFolder := filepath.Join(os.Getenv("USERPROFILE"), "Documents", "Virtual Machines")
+	vmFolder := vmFolderPath()
 
 	_, vmrunErr := os.Stat(vmrunPath)
 	_, vmwareErr := os.Stat(vmwarePath)
@@ -48,3 +55,48 @@ func (v *VM) CheckVMWareStatus() VMWareStatus {
 		VmFolderExists: vmFolderExists,
 	}
 }
+
+// InstalledVersion 은 설치된 VMware Workstation 의 파일 버전을 반환합니다.
+func (v *VM) InstalledVersion() (string, error) {
+	return utils.FileVersion(vmwarePath)
+}
+
+// VirtualMachine 은 VM 폴더에서 찾은 .vmx 파일 하나를 나타냅니다.
+type VirtualMachine struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// ListVirtualMachines 는 VM 폴더 아래의 .vmx 파일 목록을 반환합니다.
+func (v *VM) ListVirtualMachines() ([]VirtualMachine, error) {
+	vmFolder := vmFolderPath()
+	if _, err := os.Stat(vmFolder); os.IsNotExist(err) {
+		v.logger.Info("VM folder does not exist", zap.String("path", vmFolder))
+		return []VirtualMachine{}, nil
+	}
+
+	machines := []VirtualMachine{}
+	err := filepath.WalkDir(vmFolder, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".vmx") {
+			machines = append(machines, VirtualMachine{
+				Name: strings.TrimSuffix(d.Name(), filepath.Ext(d.Name())),
+				Path: path,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		v.logger.Error("Failed to list virtual machines", zap.Error(err))
+		return nil, err
+	}
+
+	v.logger.Info("Virtual machines listed", zap.Int("count", len(machines)))
+	return machines, nil
+}
+
+func vmFolderPath() string {
+	return filepath.Join(os.Getenv("USERPROFILE"), "Documents", "Virtual Machines")
+}
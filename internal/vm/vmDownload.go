@@ -8,45 +8,76 @@ import (
 	"context"
 	"fmt"
 	"go.uber.org/zap"
-	"golang.org/x/sys/windows"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
-	"syscall"
+
+	"cookieBot/internal/apperr"
+	"cookieBot/internal/audit"
+	"cookieBot/utils"
+)
+
+const (
+	vmwareDownloadURL = "https://softwareupdate.vmware.com/cds/vmw-desktop/ws/17.5.2/23775571/windows/core/VMware-workstation-17.5.2-23775571.exe.tar"
+	vmwareTarName     = "VMware-workstation-17.5.2-23775571.exe.tar"
+	extractedDirName  = "vmware_extracted"
+	// 오류 메시지의 {component} 값
+	componentName = "VMware"
 )
 
+// vmwareSHA256 은 vmwareTarName 의 SHA-256 입니다. 버전을 올릴 때 함께 바꾸며, 빌드할 때
+// -ldflags "-X cookieBot/internal/vm.vmwareSHA256=<hex>" 로 고정할 수도 있습니다. 비어 있으면 확인을 건너뜁니다.
+var vmwareSHA256 = ""
+
 type VMD struct {
 	ctx      context.Context
 	logger   *zap.Logger
 	progress int
 	mu       sync.Mutex
+	audit    *audit.Log
+}
+
+func VMDownload(ctx context.Context, logger *zap.Logger) *VMD {
+	return &VMD{ctx: ctx, logger: logger}
 }
 
-func VMDownload(logger *zap.Logger) *VMD {
-	return &VMD{logger: logger}
+// SetAuditLog 는 설치 작업을 기록할 감사 로그를 지정합니다.
+func (v *VMD) SetAuditLog(l *audit.Log) {
+	v.audit = l
 }
 
-func (v *VMD) DownloadAndInstallVMWare() error {
-	url := "https://softwareupdate.vmware.com/cds/vmw-desktop/ws/17.5.2/23775571/windows/core/VMware-workstation-17.5.2-23775571.exe.tar"
-	filePath := filepath.Join(os.TempDir(), "VMware-workstation-17.5.2-23775571.exe.tar")
+func (v *VMD) DownloadAndInstallVMWare() (err error) {
+	url := vmwareDownloadURL
+	defer func() {
+		v.audit.Record("install.vmware", vmwareTarName, map[string]interface{}{"url": url}, err)
+	}()
+	filePath := filepath.Join(os.TempDir(), vmwareTarName)
 
 	v.logger.Info("Starting VMWare download", zap.String("url", url))
 
 	// 다운로드
-	err := v.downloadFile(filePath, url)
+	err = v.downloadFile(filePath, url)
 	if err != nil {
 		v.logger.Error("Failed to download VMWare", zap.Error(err))
-		return err
+		// 앱 종료로 ctx 가 취소되었으면 실패가 아니라 취소입니다.
+		if v.ctx.Err() != nil {
+			return componentError(apperr.InstallCancelled, err)
+		}
+		return componentError(apperr.DownloadFailed, err)
+	}
+	if err = v.verifyDownload(filePath); err != nil {
+		v.logger.Error("VMWare download failed checksum verification", zap.Error(err))
+		return componentError(apperr.DownloadChecksumMismatch, err)
 	}
 
 	// 압축 해제 및 설치
 	err = v.extractAndInstallVMWare(filePath)
 	if err != nil {
 		v.logger.Error("Failed to install VMWare", zap.Error(err))
-		return err
+		return componentError(apperr.InstallFailed, err)
 	}
 
 	v.logger.Info("VMWare download and installation completed successfully")
@@ -60,11 +91,20 @@ func (v *VMD) downloadFile(filePath string, url string) error {
 	}
 	defer out.Close()
 
-	resp, err := http.Get(url)
+	// 앱 종료 시 다운로드가 취소되도록 ctx 사용
+	req, err := http.NewRequestWithContext(v.ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
 
 	totalSize := resp.ContentLength
 	downloadedSize := int64(0)
@@ -87,6 +127,24 @@ func (v *VMD) downloadFile(filePath string, url string) error {
 	return nil
 }
 
+// verifyDownload 는 내려받은 파일을 vmwareSHA256 과 비교하고, 다르면 파일을 지웁니다.
+func (v *VMD) verifyDownload(filePath string) error {
+	if vmwareSHA256 == "" {
+		v.logger.Warn("No pinned SHA-256 for the VMWare download, skipping verification")
+		return nil
+	}
+	if err := utils.VerifySHA256(filePath, vmwareSHA256); err != nil {
+		os.Remove(filePath)
+		return err
+	}
+	return nil
+}
+
+// componentError 는 VMware 설치 오류를 code 로 감쌉니다.
+func componentError(code apperr.Code, err error) error {
+	return apperr.Wrap(code, err).With("component", componentName)
+}
+
 func (v *VMD) extractAndInstallVMWare(tarPath string) error {
 	v.logger.Info("Starting to extract VMWare tar file", zap.String("tarPath", tarPath))
 
@@ -113,7 +171,7 @@ func (v *VMD) extractAndInstallVMWare(tarPath string) error {
 func (v *VMD) extractTar(reader io.Reader) error {
 	tarReader := tar.NewReader(reader)
 
-	extractedPath := filepath.Join(os.TempDir(), "vmware_extracted")
+	extractedPath := filepath.Join(os.TempDir(), extractedDirName)
 	if err := os.MkdirAll(extractedPath, 0755); err != nil {
 		return err
 	}
@@ -206,22 +264,6 @@ func (v *VMD) installVMWare(filepath string) error {
 	return nil
 }
 
-// RunAs launches the specified program with admin privileges.
-func RunAs(path string) error {
-	verbPtr, _ := syscall.UTF16PtrFromString("runas")
-	exePtr, _ := syscall.UTF16PtrFromString(path)
-	cwdPtr, _ := syscall.UTF16PtrFromString("")
-	argPtr, _ := syscall.UTF16PtrFromString("")
-
-	var showCmd int32 = 1 //SW_NORMAL
-
-	err := windows.ShellExecute(0, verbPtr, exePtr, argPtr, cwdPtr, showCmd)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 func (v *VMD) updateProgress(downloadedSize int64, totalSize int64) {
 	v.mu.Lock()
 	defer v.mu.Unlock()
@@ -233,3 +275,20 @@ func (v *VMD) GetInstallationProgress() int {
 	defer v.mu.Unlock()
 	return v.progress
 }
+
+// Cleanup removes the downloaded VMware archive and the extracted installer from the temp directory.
+func (v *VMD) Cleanup() error {
+	var firstErr error
+	for _, path := range []string{
+		filepath.Join(os.TempDir(), vmwareTarName),
+		filepath.Join(os.TempDir(), extractedDirName),
+	} {
+		if err := os.RemoveAll(path); err != nil {
+			v.logger.Warn("Failed to remove VMWare temp file", zap.String("path", path), zap.Error(err))
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
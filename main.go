@@ -3,13 +3,12 @@
 package main
 
 import (
-	"context" // context 패키지 임포트 추가
-	antidetect "cookieBot/internal/anti"
-	"cookieBot/internal/browser"
-	"cookieBot/internal/db"
-	"cookieBot/internal/vm"
+	"context"
+	"cookieBot/internal/app"
+	"cookieBot/internal/instance"
 	"cookieBot/utils"
 	"embed"
+	"errors"
 	"fmt"
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -28,6 +27,20 @@ var assets embed.FS
 var configFile embed.FS
 
 func main() {
+	// 이미 실행 중이면 인자를 넘기고 종료 (app.log, 임시 파일, 브라우저 API 를 두 인스턴스가 공유하지 않도록)
+	lock, err := instance.Acquire("cookiebot")
+	if errors.Is(err, instance.ErrAlreadyRunning) {
+		if ferr := instance.Forward("cookiebot", os.Args[1:]); ferr != nil {
+			fmt.Printf("%v; failed to forward arguments: %v\n", err, ferr)
+		}
+		return
+	}
+	if err != nil {
+		fmt.Printf("Failed to acquire instance lock: %v\n", err)
+		return
+	}
+	defer lock.Release()
+
 	logger, err := utils.InitializeLogger()
 	if err != nil {
 		fmt.Printf("Failed to initialize logger: %v\n", err)
@@ -56,26 +69,13 @@ func main() {
 		return
 	}
 
-	// context.Context 생성
-	ctx := context.Background() // 새로운 컨텍스트 생성
-
-	vmMain := vm.VMMain(logger)
-	vmDownload := vm.VMDownload(logger)
-	antiDownload := antidetect.AntiDetectDownload(ctx, logger) // ctx 전달
-	browserManager := browser.NewBrowserManager(ctx, logger)   // ctx 전달
-
-	// EmailDB 초기화
-	emailDB, err := db.NewEmailDB(configPath)
+	// 서비스 계층 초기화
+	application, err := app.New(logger, configPath)
 	if err != nil {
-		logger.Error("Failed to initialize EmailDB", zap.Error(err))
+		logger.Error("Failed to initialize application", zap.Error(err))
 		return
 	}
-
-	// 유틸리티 함수 실행
-	err = utils.AddComments()
-	if err != nil {
-		logger.Error("Failed to add comments", zap.Error(err))
-	}
+	application.AddCleanupPath(tempDir)
 
 	// Create application with options
 	err = wails.Run(&options.App{
@@ -90,13 +90,16 @@ func main() {
 			BackdropType: windows.Mica,
 			Theme:        windows.Dark,
 		},
-		Bind: []interface{}{
-			vmMain,
-			vmDownload,
-			antiDownload,
-			emailDB,
-			browserManager,
+		OnStartup: func(ctx context.Context) {
+			application.Startup(ctx)
+			application.HandleArgs(os.Args[1:])
+			go lock.Serve(application.SecondInstanceLaunched)
 		},
+		OnBeforeClose: application.BeforeClose,
+		OnShutdown:    application.Shutdown,
+		Bind:          application.Bindings(),
+		// 바인딩된 메서드의 오류를 코드와 현지화된 메시지를 담은 객체로 보냅니다.
+		ErrorFormatter: application.FormatError,
 	})
 
 	if err != nil {
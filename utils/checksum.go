@@ -0,0 +1,36 @@
+// utils/checksum.go
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrChecksumMismatch 는 내려받은 파일의 SHA-256 이 기대값과 다를 때 반환됩니다.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// VerifySHA256 은 path 파일의 SHA-256 이 want(16진수, 대소문자 무시)와 같은지 확인합니다.
+func VerifySHA256(path, want string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to hash %s: %w", filepath.Base(path), err)
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(got, strings.TrimSpace(want)) {
+		return fmt.Errorf("%w: %s has SHA-256 %s, expected %s", ErrChecksumMismatch, filepath.Base(path), got, want)
+	}
+	return nil
+}
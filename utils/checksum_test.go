@@ -0,0 +1,32 @@
+// utils/checksum_test.go
+
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifySHA256(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "installer.exe")
+	if err := os.WriteFile(path, []byte("hello\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	const digest = "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+
+	if err := VerifySHA256(path, digest); err != nil {
+		t.Fatalf("VerifySHA256: %v", err)
+	}
+	if err := VerifySHA256(path, " "+strings.ToUpper(digest)+"\n"); err != nil {
+		t.Fatalf("VerifySHA256 with upper case and spaces: %v", err)
+	}
+	if err := VerifySHA256(path, strings.Repeat("0", 64)); !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("VerifySHA256 with a wrong digest err = %v, want ErrChecksumMismatch", err)
+	}
+	if err := VerifySHA256(filepath.Join(t.TempDir(), "missing"), digest); err == nil || errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("VerifySHA256 on a missing file err = %v, want a file error", err)
+	}
+}
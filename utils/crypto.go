@@ -0,0 +1,76 @@
+// utils/crypto.go
+
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// KDFParams 는 패스프레이즈에서 키를 유도하는 Argon2id 파라미터입니다.
+// 암호화된 데이터와 함께 저장해 두어야 나중에 같은 키를 다시 만들 수 있습니다.
+type KDFParams struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+// NewKDFParams 는 임의의 salt 와 기본 Argon2id 파라미터를 반환합니다.
+func NewKDFParams() (KDFParams, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return KDFParams{}, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return KDFParams{Salt: salt, Time: 1, Memory: 64 * 1024, Threads: 4}, nil
+}
+
+// DeriveKey 는 패스프레이즈에서 AES-256 키를 유도합니다.
+func (p KDFParams) DeriveKey(passphrase string) []byte {
+	return argon2.IDKey([]byte(passphrase), p.Salt, p.Time, p.Memory, p.Threads, 32)
+}
+
+// ErrDecrypt 는 키가 틀렸거나 데이터가 손상되어 복호화에 실패했을 때 반환됩니다.
+var ErrDecrypt = errors.New("failed to decrypt: wrong passphrase or corrupted data")
+
+// Seal 은 AES-256-GCM 으로 암호화하고 nonce 를 앞에 붙여 반환합니다.
+func Seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open 은 Seal 로 암호화된 데이터를 복호화합니다.
+func Open(key, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
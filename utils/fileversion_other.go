@@ -0,0 +1,12 @@
+// utils/fileversion_other.go
+
+//go:build !windows
+
+package utils
+
+import "errors"
+
+// FileVersion 은 Windows 실행 파일의 버전 리소스를 읽습니다. 다른 플랫폼에서는 지원하지 않습니다.
+func FileVersion(path string) (string, error) {
+	return "", errors.New("file version information is only available on Windows")
+}
@@ -0,0 +1,34 @@
+// utils/fileversion_windows.go
+
+package utils
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// FileVersion 은 실행 파일의 버전 리소스에서 파일 버전(예: "17.5.2.23775571")을 읽습니다.
+func FileVersion(path string) (string, error) {
+	size, err := windows.GetFileVersionInfoSize(path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to read version of %s: %w", path, err)
+	}
+	info := make([]byte, size)
+	if err := windows.GetFileVersionInfo(path, 0, size, unsafe.Pointer(&info[0])); err != nil {
+		return "", fmt.Errorf("failed to read version of %s: %w", path, err)
+	}
+
+	var fixed *windows.VS_FIXEDFILEINFO
+	var fixedLen uint32
+	if err := windows.VerQueryValue(unsafe.Pointer(&info[0]), `\`, unsafe.Pointer(&fixed), &fixedLen); err != nil {
+		return "", fmt.Errorf("failed to read version of %s: %w", path, err)
+	}
+	if fixed == nil || fixedLen == 0 {
+		return "", fmt.Errorf("%s has no version information", path)
+	}
+	return fmt.Sprintf("%d.%d.%d.%d",
+		fixed.FileVersionMS>>16, fixed.FileVersionMS&0xffff,
+		fixed.FileVersionLS>>16, fixed.FileVersionLS&0xffff), nil
+}
@@ -11,6 +11,19 @@ import (
 
 // InitializeLogger initializes and returns a Zap logger with colorized console output and file output.
 func InitializeLogger() (*zap.Logger, error) {
+	return newLogger(zapcore.Lock(os.Stdout))
+}
+
+// InitializeCLILogger returns a logger for the headless CLI. Logs always go to the log file; console
+// output goes to stderr only when verbose is set so that stdout stays clean for JSON/table results.
+func InitializeCLILogger(verbose bool) (*zap.Logger, error) {
+	if !verbose {
+		return newLogger(nil)
+	}
+	return newLogger(zapcore.Lock(os.Stderr))
+}
+
+func newLogger(consoleOutput zapcore.WriteSyncer) (*zap.Logger, error) {
 	// 콘솔 출력용 인코더 설정
 	consoleEncoder := zapcore.NewConsoleEncoder(encoderConfig())
 
@@ -21,18 +34,11 @@ func InitializeLogger() (*zap.Logger, error) {
 	atomicLevel := zap.NewAtomicLevel()
 	atomicLevel.SetLevel(zap.DebugLevel)
 
-	// 콘솔 출력 설정
-	consoleOutput := zapcore.Lock(os.Stdout)
-
-	// 실행 파일의 디렉토리 경로 가져오기
-	executablePath, err := os.Executable()
+	// 로그 파일 경로 설정
+	logFilePath, err := LogFilePath()
 	if err != nil {
 		return nil, err
 	}
-	executableDir := filepath.Dir(executablePath)
-
-	// 로그 파일 경로 설정
-	logFilePath := filepath.Join(executableDir, "app.log")
 	logFile, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
 		return nil, err
@@ -40,10 +46,11 @@ func InitializeLogger() (*zap.Logger, error) {
 	fileOutput := zapcore.AddSync(logFile)
 
 	// 코어 설정 (콘솔 + 파일)
-	core := zapcore.NewTee(
-		zapcore.NewCore(consoleEncoder, consoleOutput, atomicLevel),
-		zapcore.NewCore(fileEncoder, fileOutput, atomicLevel),
-	)
+	cores := []zapcore.Core{zapcore.NewCore(fileEncoder, fileOutput, atomicLevel)}
+	if consoleOutput != nil {
+		cores = append(cores, zapcore.NewCore(consoleEncoder, consoleOutput, atomicLevel))
+	}
+	core := zapcore.NewTee(cores...)
 
 	// 로거 생성
 	logger := zap.New(core, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
@@ -51,6 +58,15 @@ func InitializeLogger() (*zap.Logger, error) {
 	return logger, nil
 }
 
+// LogFilePath returns the path of app.log next to the executable.
+func LogFilePath() (string, error) {
+	executablePath, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(executablePath), "app.log"), nil
+}
+
 // encoderConfig returns a Zap encoder configuration with colorized output.
 func encoderConfig() zapcore.EncoderConfig {
 	return zapcore.EncoderConfig{
@@ -0,0 +1,48 @@
+// utils/paths.go
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AppDataDir 는 앱이 로컬 데이터(템플릿, 감사 로그 등)를 저장하는 사용자별 디렉토리를 반환합니다.
+// Windows 에서는 %AppData%\cookieBot 이며, 없으면 만듭니다.
+func AppDataDir() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve user config directory: %w", err)
+	}
+	dir := filepath.Join(base, "cookieBot")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create app data directory: %w", err)
+	}
+	return dir, nil
+}
+
+// WriteFileAtomic 은 임시 파일에 쓴 뒤 이름을 바꿔, 쓰는 도중 종료되어도 기존 파일이 깨지지 않게 합니다.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}